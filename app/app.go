@@ -27,7 +27,9 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
 	tmos "github.com/cometbft/cometbft/libs/os"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
@@ -121,6 +123,10 @@ import (
 	_ "github.com/evmos/ethermint/client/docs/statik"
 
 	"github.com/evmos/ethermint/app/ante"
+	bankprecompile "github.com/evmos/ethermint/precompiles/bank"
+	distributionprecompile "github.com/evmos/ethermint/precompiles/distribution"
+	ics20precompile "github.com/evmos/ethermint/precompiles/ics20"
+	stakingprecompile "github.com/evmos/ethermint/precompiles/staking"
 	srvflags "github.com/evmos/ethermint/server/flags"
 	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm"
@@ -481,6 +487,14 @@ func NewEthermintApp(
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
+	// the precompiles are set once the keepers they use are created
+	app.EvmKeeper.WithPrecompiles(map[common.Address]contract.StatefulPrecompiledContract{
+		bankprecompile.Address:         bankprecompile.NewContract(app.BankKeeper, app.EvmKeeper),
+		stakingprecompile.Address:      stakingprecompile.NewContract(stakingKeeper),
+		distributionprecompile.Address: distributionprecompile.NewContract(app.DistrKeeper),
+		ics20precompile.Address:        ics20precompile.NewContract(app.TransferKeeper, app.IBCKeeper.ChannelKeeper),
+	})
+
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
 		appCodec, keys[evidencetypes.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
@@ -16,7 +16,9 @@
 package indexer
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 
 	errorsmod "cosmossdk.io/errors"
 	dbm "github.com/cometbft/cometbft-db"
@@ -28,32 +30,47 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
-	rpctypes "github.com/evmos/ethermint/rpc/types"
 
 	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 const (
-	KeyPrefixTxHash  = 1
-	KeyPrefixTxIndex = 2
+	KeyPrefixTxHash     = 1
+	KeyPrefixTxIndex    = 2
+	KeyPrefixLogAddress = 3
+	KeyPrefixLogTopic   = 4
+	KeyPrefixLogBlock   = 5
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
+	// LogBlockKeyLength is the length of log-block key
+	LogBlockKeyLength = 1 + 8
 )
 
-var _ ethermint.EVMTxIndexer = &KVIndexer{}
+var (
+	_ ethermint.EVMTxIndexer  = &KVIndexer{}
+	_ ethermint.EVMLogIndexer = &KVIndexer{}
+)
 
 // KVIndexer implements a eth tx indexer on a KV db.
 type KVIndexer struct {
 	db        dbm.DB
 	logger    log.Logger
 	clientCtx client.Context
+	indexLogs bool
 }
 
 // NewKVIndexer creates the KVIndexer
 func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
-	return &KVIndexer{db, logger, clientCtx}
+	return &KVIndexer{db: db, logger: logger, clientCtx: clientCtx}
+}
+
+// EnableLogIndex makes IndexBlock also index the addresses and first topics of the
+// logs emitted in every block, so the blocks containing them can be found with
+// GetLogBlocks instead of scanning the whole block range.
+func (kv *KVIndexer) EnableLogIndex() {
+	kv.indexLogs = true
 }
 
 // IndexBlock index all the eth txs in a block through the following steps:
@@ -61,73 +78,24 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Indexes the addresses and first topics of the block logs if the log index is enabled
 func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
-	height := block.Header.Height
-
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
-	// record index of valid eth tx during the iteration
-	var ethTxIndex int32
-	for txIndex, tx := range block.Txs {
-		result := txResults[txIndex]
-		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(result) {
-			continue
-		}
-
-		tx, err := kv.clientCtx.TxConfig.TxDecoder()(tx)
-		if err != nil {
-			kv.logger.Error("Fail to decode tx", "err", err, "block", height, "txIndex", txIndex)
-			continue
-		}
-
-		if !isEthTx(tx) {
-			continue
-		}
-
-		txs, err := rpctypes.ParseTxResult(result, tx)
-		if err != nil {
-			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
-			continue
+	if kv.indexLogs {
+		if err := saveBlockLogs(batch, block.Height, txResults); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", block.Height)
 		}
+	}
 
-		var cumulativeGasUsed uint64
-		for msgIndex, msg := range tx.GetMsgs() {
-			ethMsg := msg.(*evmtypes.MsgEthereumTx)
-			txHash := common.HexToHash(ethMsg.Hash)
-
-			txResult := ethermint.TxResult{
-				Height:     height,
-				TxIndex:    uint32(txIndex),
-				MsgIndex:   uint32(msgIndex),
-				EthTxIndex: ethTxIndex,
-			}
-			if result.Code != abci.CodeTypeOK {
-				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
-				// some old versions don't emit any events, so workaround here directly.
-				txResult.GasUsed = ethMsg.GetGas()
-				txResult.Failed = true
-			} else {
-				parsedTx := txs.GetTxByMsgIndex(msgIndex)
-				if parsedTx == nil {
-					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
-					continue
-				}
-				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
-					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
-				}
-				txResult.GasUsed = parsedTx.GasUsed
-				txResult.Failed = parsedTx.Failed
-			}
-
-			cumulativeGasUsed += txResult.GasUsed
-			txResult.CumulativeGasUsed = cumulativeGasUsed
-			ethTxIndex++
-
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return errorsmod.Wrapf(err, "IndexBlock %d", height)
-			}
-		}
+	err := forEachEthTxResult(kv.clientCtx, kv.logger, block, txResults,
+		func(ethMsg *evmtypes.MsgEthereumTx, txResult *ethermint.TxResult) error {
+			return saveTxResult(kv.clientCtx.Codec, batch, common.HexToHash(ethMsg.Hash), txResult)
+		},
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", block.Height)
 	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
@@ -173,6 +141,112 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ethe
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// LogIndexedRange returns the first and last block covered by the log index, returns
+// -1 if the log index is empty
+func (kv *KVIndexer) LogIndexedRange() (int64, int64, error) {
+	first, err := loadLogBlock(kv.db, false)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexedRange")
+	}
+	last, err := loadLogBlock(kv.db, true)
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexedRange")
+	}
+	return first, last, nil
+}
+
+// LogUnindexedBlocks finds the blocks within [from, to] which are not covered by the
+// log index
+func (kv *KVIndexer) LogUnindexedBlocks(from, to int64) ([]int64, error) {
+	it, err := kv.db.Iterator(LogBlockKey(from), LogBlockKey(to+1))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "LogUnindexedBlocks")
+	}
+	defer it.Close()
+
+	heights := []int64{}
+	next := from
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if len(key) != LogBlockKeyLength {
+			return nil, fmt.Errorf("wrong log block key length, expect: %d, got: %d", LogBlockKeyLength, len(key))
+		}
+		indexed := int64(sdk.BigEndianToUint64(key[1:]))
+		for ; next < indexed; next++ {
+			heights = append(heights, next)
+		}
+		next = indexed + 1
+	}
+	if err := it.Error(); err != nil {
+		return nil, errorsmod.Wrap(err, "LogUnindexedBlocks")
+	}
+	for ; next <= to; next++ {
+		heights = append(heights, next)
+	}
+	return heights, nil
+}
+
+// GetLogBlocks finds the blocks within [from, to] containing logs emitted by one of
+// the addresses and logs with one of the topics0 as first topic
+func (kv *KVIndexer) GetLogBlocks(addresses []common.Address, topics0 []common.Hash, from, to int64) ([]int64, error) {
+	if len(addresses) == 0 && len(topics0) == 0 {
+		return nil, errors.New("GetLogBlocks requires at least one address or topic")
+	}
+
+	var byAddress, byTopic map[int64]bool
+	if len(addresses) > 0 {
+		byAddress = make(map[int64]bool)
+		for _, address := range addresses {
+			if err := kv.loadLogHeights(LogAddressKeyPrefix(address), from, to, byAddress); err != nil {
+				return nil, errorsmod.Wrapf(err, "GetLogBlocks address %s", address.Hex())
+			}
+		}
+	}
+	if len(topics0) > 0 {
+		byTopic = make(map[int64]bool)
+		for _, topic := range topics0 {
+			if err := kv.loadLogHeights(LogTopicKeyPrefix(topic), from, to, byTopic); err != nil {
+				return nil, errorsmod.Wrapf(err, "GetLogBlocks topic %s", topic.Hex())
+			}
+		}
+	}
+
+	matches := byAddress
+	if matches == nil {
+		matches = byTopic
+	} else if byTopic != nil {
+		for height := range matches {
+			if !byTopic[height] {
+				delete(matches, height)
+			}
+		}
+	}
+
+	heights := make([]int64, 0, len(matches))
+	for height := range matches {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights, nil
+}
+
+// loadLogHeights adds the heights within [from, to] of the log index entries under the
+// prefix to the heights set
+func (kv *KVIndexer) loadLogHeights(prefix []byte, from, to int64, heights map[int64]bool) error {
+	start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(from))...)
+	end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(to+1))...)
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		heights[int64(sdk.BigEndianToUint64(key[len(prefix):]))] = true
+	}
+	return it.Error()
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -185,6 +259,22 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// LogAddressKeyPrefix returns the prefix of the db entries: `(address, block number) -> nil`
+func LogAddressKeyPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+}
+
+// LogTopicKeyPrefix returns the prefix of the db entries: `(topic, block number) -> nil`
+func LogTopicKeyPrefix(topic common.Hash) []byte {
+	return append([]byte{KeyPrefixLogTopic}, topic.Bytes()...)
+}
+
+// LogBlockKey returns the key for db entry: `block number -> nil`, which marks the
+// blocks covered by the log index
+func LogBlockKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixLogBlock}, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -236,6 +326,58 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// saveBlockLogs index the addresses and first topics of the block logs into the kv db batch
+func saveBlockLogs(batch dbm.Batch, height int64, txResults []*abci.ResponseDeliverTx) error {
+	heightBz := sdk.Uint64ToBigEndian(uint64(height))
+	for _, txResult := range txResults {
+		logs, err := parseTxLogs(txResult.Events)
+		if err != nil {
+			return errorsmod.Wrap(err, "parse tx logs")
+		}
+		for _, txLog := range logs {
+			if err := batch.Set(append(LogAddressKeyPrefix(common.HexToAddress(txLog.Address)), heightBz...), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log-address key")
+			}
+			if len(txLog.Topics) == 0 {
+				continue
+			}
+			if err := batch.Set(append(LogTopicKeyPrefix(common.HexToHash(txLog.Topics[0])), heightBz...), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set log-topic key")
+			}
+		}
+	}
+	if err := batch.Set(LogBlockKey(height), []byte{}); err != nil {
+		return errorsmod.Wrap(err, "set log-block key")
+	}
+	return nil
+}
+
+// loadLogBlock loads the first or last block covered by the log index, returns -1 if
+// the log index is empty
+func loadLogBlock(db dbm.DB, last bool) (int64, error) {
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if last {
+		it, err = db.ReverseIterator([]byte{KeyPrefixLogBlock}, []byte{KeyPrefixLogBlock + 1})
+	} else {
+		it, err = db.Iterator([]byte{KeyPrefixLogBlock}, []byte{KeyPrefixLogBlock + 1})
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	key := it.Key()
+	if len(key) != LogBlockKeyLength {
+		return 0, fmt.Errorf("wrong log block key length, expect: %d, got: %d", LogBlockKeyLength, len(key))
+	}
+	return int64(sdk.BigEndianToUint64(key[1:])), nil
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
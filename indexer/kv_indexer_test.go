@@ -1,6 +1,7 @@
 package indexer_test
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 
@@ -183,6 +184,107 @@ func TestKVIndexer(t *testing.T) {
 	}
 }
 
+func TestKVIndexerLogIndex(t *testing.T) {
+	addr1 := common.BigToAddress(big.NewInt(1))
+	addr2 := common.BigToAddress(big.NewInt(2))
+	topic1 := common.BigToHash(big.NewInt(1))
+	topic2 := common.BigToHash(big.NewInt(2))
+
+	logEvent := func(address common.Address, topics ...common.Hash) abci.Event {
+		topicsHex := make([]string, len(topics))
+		for i, topic := range topics {
+			topicsHex[i] = topic.Hex()
+		}
+		bz, err := json.Marshal(&types.Log{Address: address.Hex(), Topics: topicsHex})
+		require.NoError(t, err)
+		return abci.Event{Type: types.EventTypeTxLog, Attributes: []abci.EventAttribute{
+			{Key: types.AttributeKeyTxLog, Value: string(bz)},
+		}}
+	}
+	blockResults := map[int64][]*abci.ResponseDeliverTx{
+		1: {{Events: []abci.Event{logEvent(addr1, topic1)}}},
+		2: {},
+		3: {{Events: []abci.Event{logEvent(addr2, topic1), logEvent(addr1)}}},
+		4: {{Events: []abci.Event{logEvent(addr2, topic2, topic1)}}},
+	}
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	testCases := []struct {
+		name      string
+		addresses []common.Address
+		topics0   []common.Hash
+		from, to  int64
+		expBlocks []int64
+	}{
+		{"address", []common.Address{addr1}, nil, 1, 4, []int64{1, 3}},
+		{"addresses", []common.Address{addr1, addr2}, nil, 1, 4, []int64{1, 3, 4}},
+		{"topic", nil, []common.Hash{topic1}, 1, 4, []int64{1, 3}},
+		{"only first topic", nil, []common.Hash{topic2}, 1, 4, []int64{4}},
+		{"address and topic", []common.Address{addr2}, []common.Hash{topic2}, 1, 4, []int64{4}},
+		{"address and topic in different logs", []common.Address{addr1}, []common.Hash{topic1}, 1, 4, []int64{1, 3}},
+		{"block range", []common.Address{addr1, addr2}, nil, 2, 3, []int64{3}},
+		{"no match", []common.Address{common.BigToAddress(big.NewInt(3))}, nil, 1, 4, []int64{}},
+	}
+
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+
+	// the log index is disabled by default
+	require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 1}}, blockResults[1]))
+	first, last, err := idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	require.Equal(t, int64(-1), last)
+
+	idxer.EnableLogIndex()
+	for height := int64(1); height <= 4; height++ {
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}}
+		require.NoError(t, idxer.IndexBlock(block, blockResults[height]))
+	}
+	first, last, err = idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(4), last)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blocks, err := idxer.GetLogBlocks(tc.addresses, tc.topics0, tc.from, tc.to)
+			require.NoError(t, err)
+			require.Equal(t, tc.expBlocks, blocks)
+		})
+	}
+
+	_, err = idxer.GetLogBlocks(nil, nil, 1, 4)
+	require.Error(t, err)
+
+	// the blocks indexed while the log index is disabled are reported as unindexed
+	db := dbm.NewMemDB()
+	idxer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+	idxer.EnableLogIndex()
+	require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 1}}, blockResults[1]))
+	idxer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+	require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 2}}, blockResults[2]))
+	require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 3}}, blockResults[3]))
+	idxer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+	idxer.EnableLogIndex()
+	require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: 4}}, blockResults[4]))
+
+	first, last, err = idxer.LogIndexedRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(4), last)
+	unindexed, err := idxer.LogUnindexedBlocks(1, 4)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 3}, unindexed)
+	unindexed, err = idxer.LogUnindexedBlocks(3, 6)
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 5, 6}, unindexed)
+	unindexed, err = idxer.LogUnindexedBlocks(4, 4)
+	require.NoError(t, err)
+	require.Empty(t, unindexed)
+}
+
 // MakeEncodingConfig creates the EncodingConfig
 func MakeEncodingConfig() params.EncodingConfig {
 	return evmenc.MakeConfig(app.ModuleBasics)
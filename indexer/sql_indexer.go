@@ -0,0 +1,287 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	// register the sqlite3 database/sql driver
+	_ "github.com/mattn/go-sqlite3"
+
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+const (
+	// SQLDriverName is the database/sql driver used by the SQLIndexer.
+	SQLDriverName = "sqlite3"
+
+	// sqlDSNOptions are the options of the SQLite connections. The readers of
+	// the RPC wait for the write transaction of IndexBlock instead of failing
+	// with SQLITE_BUSY, and the WAL journal lets them read concurrently.
+	sqlDSNOptions = "?_busy_timeout=5000&_journal_mode=WAL"
+)
+
+// sqlSchema creates the table of the indexed eth txs. Besides the fields of the
+// TxResult, every row stores the sender, recipient, nonce and status of the tx so
+// they can be queried directly, the addresses are stored as lowercase hex strings
+// and the recipient is NULL for contract creations.
+const sqlSchema = `
+CREATE TABLE IF NOT EXISTS eth_txs (
+	hash                TEXT    NOT NULL PRIMARY KEY,
+	height              INTEGER NOT NULL,
+	tx_index            INTEGER NOT NULL,
+	msg_index           INTEGER NOT NULL,
+	eth_tx_index        INTEGER NOT NULL,
+	gas_used            INTEGER NOT NULL,
+	cumulative_gas_used INTEGER NOT NULL,
+	status              INTEGER NOT NULL,
+	sender              TEXT    NOT NULL,
+	recipient           TEXT,
+	contract_address    TEXT,
+	nonce               INTEGER NOT NULL,
+	UNIQUE (height, eth_tx_index)
+);
+CREATE INDEX IF NOT EXISTS eth_txs_sender ON eth_txs (sender);
+CREATE INDEX IF NOT EXISTS eth_txs_recipient ON eth_txs (recipient);
+CREATE INDEX IF NOT EXISTS eth_txs_contract_address ON eth_txs (contract_address);
+`
+
+const sqlSelectTxResult = `
+SELECT height, tx_index, msg_index, eth_tx_index, gas_used, cumulative_gas_used, status
+FROM eth_txs`
+
+var (
+	_ ethermint.EVMTxIndexer      = &SQLIndexer{}
+	_ ethermint.EVMTxQueryIndexer = &SQLIndexer{}
+)
+
+// SQLIndexer implements a eth tx indexer on an embedded SQL database.
+type SQLIndexer struct {
+	db        *sql.DB
+	logger    log.Logger
+	clientCtx client.Context
+}
+
+// OpenSQLIndexerDB opens the embedded SQL database stored at the given file path.
+func OpenSQLIndexerDB(path string) (*sql.DB, error) {
+	return sql.Open(SQLDriverName, path+sqlDSNOptions)
+}
+
+// NewSQLIndexer creates the SQLIndexer, creating the indexer tables if they don't
+// exist yet.
+func NewSQLIndexer(db *sql.DB, logger log.Logger, clientCtx client.Context) (*SQLIndexer, error) {
+	if _, err := db.Exec(sqlSchema); err != nil {
+		return nil, errorsmod.Wrap(err, "create indexer schema")
+	}
+	return &SQLIndexer{db, logger, clientCtx}, nil
+}
+
+// IndexBlock index all the eth txs in a block in a single SQL transaction, see
+// KVIndexer.IndexBlock for the details of how the tx results are built.
+func (si *SQLIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
+	dbTx, err := si.db.Begin()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, begin transaction", block.Height)
+	}
+	defer dbTx.Rollback() //nolint:errcheck
+
+	stmt, err := dbTx.Prepare(`
+INSERT OR REPLACE INTO eth_txs (
+	hash, height, tx_index, msg_index, eth_tx_index, gas_used, cumulative_gas_used,
+	status, sender, recipient, contract_address, nonce
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, prepare statement", block.Height)
+	}
+	defer stmt.Close()
+
+	err = forEachEthTxResult(si.clientCtx, si.logger, block, txResults,
+		func(ethMsg *evmtypes.MsgEthereumTx, txResult *ethermint.TxResult) error {
+			tx := ethMsg.AsTransaction()
+			from, err := ethMsg.GetSender(tx.ChainId())
+			if err != nil {
+				return errorsmod.Wrapf(err, "recover sender of tx %s", ethMsg.Hash)
+			}
+
+			var recipient, contractAddress sql.NullString
+			if tx.To() != nil {
+				recipient = sql.NullString{String: hexAddress(*tx.To()), Valid: true}
+			} else {
+				contractAddress = sql.NullString{String: hexAddress(crypto.CreateAddress(from, tx.Nonce())), Valid: true}
+			}
+
+			status := ethtypes.ReceiptStatusSuccessful
+			if txResult.Failed {
+				status = ethtypes.ReceiptStatusFailed
+			}
+
+			_, err = stmt.Exec(
+				common.HexToHash(ethMsg.Hash).Hex(), txResult.Height, txResult.TxIndex, txResult.MsgIndex,
+				txResult.EthTxIndex, txResult.GasUsed, txResult.CumulativeGasUsed,
+				status, hexAddress(from), recipient, contractAddress, tx.Nonce(),
+			)
+			return err
+		},
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", block.Height)
+	}
+	if err := dbTx.Commit(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, commit transaction", block.Height)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (si *SQLIndexer) LastIndexedBlock() (int64, error) {
+	return si.loadBlock("MAX")
+}
+
+// FirstIndexedBlock returns the first indexed block number, returns -1 if db is empty
+func (si *SQLIndexer) FirstIndexedBlock() (int64, error) {
+	return si.loadBlock("MIN")
+}
+
+// GetByTxHash finds eth tx by eth tx hash
+func (si *SQLIndexer) GetByTxHash(hash common.Hash) (*ethermint.TxResult, error) {
+	row := si.db.QueryRow(sqlSelectTxResult+" WHERE hash = ?", hash.Hex())
+	txResult, err := scanTxResult(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return txResult, nil
+}
+
+// GetByBlockAndIndex finds eth tx by block number and eth tx index
+func (si *SQLIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ethermint.TxResult, error) {
+	row := si.db.QueryRow(sqlSelectTxResult+" WHERE height = ? AND eth_tx_index = ?", blockNumber, txIndex)
+	txResult, err := scanTxResult(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	return txResult, nil
+}
+
+// GetBySender returns the hashes of the eth txs sent by the address, ordered
+// by block and index, skipping the first offset ones and returning up to limit.
+func (si *SQLIndexer) GetBySender(sender common.Address, offset, limit int) ([]common.Hash, error) {
+	hashes, err := si.queryHashes("sender = ?", hexAddress(sender), offset, limit)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetBySender %s", sender.Hex())
+	}
+	return hashes, nil
+}
+
+// GetByRecipient returns the hashes of the eth txs sent to the address, ordered
+// by block and index, skipping the first offset ones and returning up to limit.
+func (si *SQLIndexer) GetByRecipient(recipient common.Address, offset, limit int) ([]common.Hash, error) {
+	hashes, err := si.queryHashes("recipient = ?", hexAddress(recipient), offset, limit)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByRecipient %s", recipient.Hex())
+	}
+	return hashes, nil
+}
+
+// GetContractCreation returns the hash of the eth tx which created the contract
+// at the address.
+func (si *SQLIndexer) GetContractCreation(contract common.Address) (common.Hash, error) {
+	var hash string
+	err := si.db.QueryRow("SELECT hash FROM eth_txs WHERE contract_address = ?", hexAddress(contract)).Scan(&hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return common.Hash{}, fmt.Errorf("contract creation not found, address: %s", contract.Hex())
+	}
+	if err != nil {
+		return common.Hash{}, errorsmod.Wrapf(err, "GetContractCreation %s", contract.Hex())
+	}
+	return common.HexToHash(hash), nil
+}
+
+// queryHashes returns the hashes of the eth txs matching the condition, ordered
+// by block and index.
+func (si *SQLIndexer) queryHashes(condition string, arg interface{}, offset, limit int) ([]common.Hash, error) {
+	rows, err := si.db.Query(
+		"SELECT hash FROM eth_txs WHERE "+condition+" ORDER BY height, eth_tx_index LIMIT ? OFFSET ?",
+		arg, limit, offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hashes []common.Hash
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, common.HexToHash(hash))
+	}
+	return hashes, rows.Err()
+}
+
+// loadBlock returns the result of the given aggregate function over the indexed
+// block numbers, returns -1 if db is empty
+func (si *SQLIndexer) loadBlock(aggregate string) (int64, error) {
+	var height sql.NullInt64
+	if err := si.db.QueryRow(fmt.Sprintf("SELECT %s(height) FROM eth_txs", aggregate)).Scan(&height); err != nil {
+		return 0, errorsmod.Wrapf(err, "load %s block", strings.ToLower(aggregate))
+	}
+	if !height.Valid {
+		return -1, nil
+	}
+	return height.Int64, nil
+}
+
+// scanTxResult reads a TxResult from a row selected with sqlSelectTxResult
+func scanTxResult(row *sql.Row) (*ethermint.TxResult, error) {
+	var (
+		txResult ethermint.TxResult
+		status   uint64
+	)
+	err := row.Scan(
+		&txResult.Height, &txResult.TxIndex, &txResult.MsgIndex, &txResult.EthTxIndex,
+		&txResult.GasUsed, &txResult.CumulativeGasUsed, &status,
+	)
+	if err != nil {
+		return nil, err
+	}
+	txResult.Failed = status == ethtypes.ReceiptStatusFailed
+	return &txResult, nil
+}
+
+// hexAddress returns the lowercase hex representation of the address
+func hexAddress(addr common.Address) string {
+	return strings.ToLower(addr.Hex())
+}
@@ -0,0 +1,220 @@
+package indexer_test
+
+import (
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/indexer"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLIndexer(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(
+		nil, 0, &to, big.NewInt(1000), 21000, nil, nil, nil, nil, nil,
+	)
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		block       *tmtypes.Block
+		blockResult []*abci.ResponseDeliverTx
+		expSuccess  bool
+		expFailed   bool
+	}{
+		{
+			"success",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{
+				{
+					Code: 0,
+					Events: []abci.Event{
+						{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+							{Key: "ethereumTxHash", Value: txHash.Hex()},
+							{Key: "txIndex", Value: "0"},
+							{Key: "amount", Value: "1000"},
+							{Key: "txGasUsed", Value: "21000"},
+							{Key: "txHash", Value: ""},
+							{Key: "recipient", Value: to.Hex()},
+						}},
+					},
+				},
+			},
+			true,
+			false,
+		},
+		{
+			"success, exceed block gas limit",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{
+				{
+					Code:   11,
+					Log:    "out of gas in location: block gas meter; gasWanted: 21000",
+					Events: []abci.Event{},
+				},
+			},
+			true,
+			true,
+		},
+		{
+			"fail, invalid events",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{
+				{
+					Code:   0,
+					Events: []abci.Event{},
+				},
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, err := indexer.OpenSQLIndexerDB(filepath.Join(t.TempDir(), "evmindexer.sqlite"))
+			require.NoError(t, err)
+			defer db.Close()
+
+			idxer, err := indexer.NewSQLIndexer(db, tmlog.NewNopLogger(), clientCtx)
+			require.NoError(t, err)
+
+			err = idxer.IndexBlock(tc.block, tc.blockResult)
+			require.NoError(t, err)
+			if !tc.expSuccess {
+				first, err := idxer.FirstIndexedBlock()
+				require.NoError(t, err)
+				require.Equal(t, int64(-1), first)
+
+				last, err := idxer.LastIndexedBlock()
+				require.NoError(t, err)
+				require.Equal(t, int64(-1), last)
+
+				_, err = idxer.GetByTxHash(txHash)
+				require.Error(t, err)
+				return
+			}
+
+			first, err := idxer.FirstIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, tc.block.Header.Height, first)
+
+			last, err := idxer.LastIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, tc.block.Header.Height, last)
+
+			res1, err := idxer.GetByTxHash(txHash)
+			require.NoError(t, err)
+			require.NotNil(t, res1)
+			require.Equal(t, tc.expFailed, res1.Failed)
+			res2, err := idxer.GetByBlockAndIndex(1, 0)
+			require.NoError(t, err)
+			require.Equal(t, res1, res2)
+
+			// the sender, recipient and nonce can be queried directly
+			var (
+				sender, recipient string
+				nonce             uint64
+			)
+			err = db.QueryRow("SELECT sender, recipient, nonce FROM eth_txs WHERE hash = ?", txHash.Hex()).
+				Scan(&sender, &recipient, &nonce)
+			require.NoError(t, err)
+			require.Equal(t, strings.ToLower(from.Hex()), sender)
+			require.Equal(t, strings.ToLower(to.Hex()), recipient)
+			require.Equal(t, uint64(0), nonce)
+
+			hashes, err := idxer.GetBySender(from, 0, 10)
+			require.NoError(t, err)
+			require.Equal(t, []common.Hash{txHash}, hashes)
+			hashes, err = idxer.GetBySender(from, 1, 10)
+			require.NoError(t, err)
+			require.Empty(t, hashes)
+			hashes, err = idxer.GetByRecipient(to, 0, 10)
+			require.NoError(t, err)
+			require.Equal(t, []common.Hash{txHash}, hashes)
+			hashes, err = idxer.GetByRecipient(from, 0, 10)
+			require.NoError(t, err)
+			require.Empty(t, hashes)
+			_, err = idxer.GetContractCreation(to)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSQLIndexerContractCreation(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+
+	tx := types.NewTx(nil, 0, nil, big.NewInt(0), 100000, nil, nil, nil, []byte{0x00}, nil)
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethtypes.LatestSignerForChainID(nil), tests.NewSigner(priv)))
+	txHash := tx.AsTransaction().Hash()
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	db, err := indexer.OpenSQLIndexerDB(filepath.Join(t.TempDir(), "evmindexer.sqlite"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	// the readers don't fail while a block is indexed
+	var journalMode string
+	require.NoError(t, db.QueryRow("PRAGMA journal_mode").Scan(&journalMode))
+	require.Equal(t, "wal", journalMode)
+
+	idxer, err := indexer.NewSQLIndexer(db, tmlog.NewNopLogger(), clientCtx)
+	require.NoError(t, err)
+	err = idxer.IndexBlock(
+		&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+		[]*abci.ResponseDeliverTx{{
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "txGasUsed", Value: "60000"},
+				}},
+			},
+		}},
+	)
+	require.NoError(t, err)
+
+	hash, err := idxer.GetContractCreation(crypto.CreateAddress(from, 0))
+	require.NoError(t, err)
+	require.Equal(t, txHash, hash)
+	hashes, err := idxer.GetByRecipient(crypto.CreateAddress(from, 0), 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, hashes)
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.0;
+
+/// @dev The bank precompiled contract, at 0x0000000000000000000000000000000000000804.
+/// It exposes the x/bank denoms as ERC-20 tokens, every function takes the
+/// denom as first argument. The standard ERC-20 events of a denom are emitted
+/// by its token address, see tokenAddress.
+interface IBank {
+    event Transfer(address indexed from, address indexed to, uint256 value);
+    event Approval(address indexed owner, address indexed spender, uint256 value);
+
+    function balanceOf(string calldata denom, address account) external view returns (uint256);
+
+    function totalSupply(string calldata denom) external view returns (uint256);
+
+    /// @dev The exponent of the display unit of the denom metadata, zero without metadata.
+    function decimals(string calldata denom) external view returns (uint8);
+
+    function allowance(string calldata denom, address owner, address spender) external view returns (uint256);
+
+    function approve(string calldata denom, address spender, uint256 amount) external returns (bool);
+
+    /// @dev The address emitting the events of the denom, the last 20 bytes of keccak256("bank:" + denom).
+    /// It has no code, the denom is only transferred through this contract.
+    function tokenAddress(string calldata denom) external pure returns (address);
+
+    function transfer(string calldata denom, address to, uint256 amount) external returns (bool);
+
+    function transferFrom(string calldata denom, address from, address to, uint256 amount) external returns (bool);
+}
@@ -0,0 +1,415 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package bank
+
+import (
+	_ "embed"
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/ethereum/go-ethereum/vmerrs"
+
+	"github.com/evmos/ethermint/precompiles"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+const (
+	readGasCost  uint64 = contract.ReadGasCostPerSlot
+	writeGasCost uint64 = contract.WriteGasCostPerSlot
+	// tokenAddressGasCost is the cost of hashing the denom, it reads no state
+	tokenAddressGasCost uint64 = 100
+)
+
+var (
+	// Address is the address of the bank precompiled contract.
+	Address = common.HexToAddress("0x0000000000000000000000000000000000000804")
+
+	// RawABI is the ABI of the bank precompiled contract.
+	//go:embed IBank.abi
+	RawABI string
+	// ABI is the parsed ABI of the bank precompiled contract.
+	ABI = contract.MustParseABI(RawABI)
+)
+
+func init() {
+	statedb.RegisterPrecompile(Address)
+}
+
+// TokenAddress returns the address emitting the ERC-20 events of the denom,
+// the last 20 bytes of the keccak256 hash of "bank:" and the denom. It has no
+// code, the denom is only transferred through the contract.
+func TokenAddress(denom string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte("bank:" + denom)))
+}
+
+// BankKeeper defines the expected bank keeper of the contract.
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
+	BlockedAddr(addr sdk.AccAddress) bool
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// EVMKeeper defines the expected EVM keeper of the contract.
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+}
+
+// bankContract exposes the x/bank denoms as ERC-20 tokens. Every function takes
+// the denom as first argument. The standard ERC-20 events of a denom are
+// emitted by its TokenAddress, so they're indexed like the ones of a token.
+type bankContract struct {
+	bankKeeper BankKeeper
+	evmKeeper  EVMKeeper
+}
+
+// NewContract creates the bank precompiled contract, run at Address once it's
+// enabled in the EVM params.
+func NewContract(bankKeeper BankKeeper, evmKeeper EVMKeeper) contract.StatefulPrecompiledContract {
+	c := &bankContract{bankKeeper: bankKeeper, evmKeeper: evmKeeper}
+
+	var functions []*contract.StatefulPrecompileFunction
+	for name, run := range map[string]contract.RunStatefulPrecompileFunc{
+		"balanceOf":    c.balanceOf,
+		"totalSupply":  c.totalSupply,
+		"decimals":     c.decimals,
+		"tokenAddress": c.tokenAddress,
+		"allowance":    c.allowance,
+		"approve":      c.approve,
+		"transfer":     c.transfer,
+		"transferFrom": c.transferFrom,
+	} {
+		functions = append(functions, contract.NewStatefulPrecompileFunction(ABI.Methods[name].ID, run))
+	}
+
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+	if err != nil {
+		panic(err)
+	}
+	return precompile
+}
+
+func (c *bankContract) balanceOf(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	if remainingGas, err = contract.DeductGas(suppliedGas, readGasCost); err != nil {
+		return nil, 0, err
+	}
+	method := ABI.Methods["balanceOf"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	denom, account := args[0].(string), args[1].(common.Address)
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	ctx := stateDB.CacheContext()
+
+	// the balance of the EVM denom is the one of the ongoing EVM execution
+	var balance *big.Int
+	if denom == c.evmKeeper.GetParams(ctx).EvmDenom {
+		balance = stateDB.GetBalance(account)
+	} else {
+		balance = c.bankKeeper.GetBalance(ctx, account.Bytes(), denom).Amount.BigInt()
+	}
+
+	ret, err = method.Outputs.Pack(balance)
+	return ret, remainingGas, err
+}
+
+func (c *bankContract) totalSupply(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	if remainingGas, err = contract.DeductGas(suppliedGas, readGasCost); err != nil {
+		return nil, 0, err
+	}
+	method := ABI.Methods["totalSupply"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	denom := args[0].(string)
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	supply := c.bankKeeper.GetSupply(stateDB.CacheContext(), denom)
+
+	ret, err = method.Outputs.Pack(supply.Amount.BigInt())
+	return ret, remainingGas, err
+}
+
+// decimals returns the exponent of the display unit of the denom metadata, or
+// zero if the denom has no metadata.
+func (c *bankContract) decimals(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	if remainingGas, err = contract.DeductGas(suppliedGas, readGasCost); err != nil {
+		return nil, 0, err
+	}
+	method := ABI.Methods["decimals"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	denom := args[0].(string)
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+
+	var decimals uint8
+	if metadata, found := c.bankKeeper.GetDenomMetaData(stateDB.CacheContext(), denom); found {
+		for _, unit := range metadata.DenomUnits {
+			if unit.Denom == metadata.Display && unit.Exponent <= 255 {
+				decimals = uint8(unit.Exponent)
+			}
+		}
+	}
+
+	ret, err = method.Outputs.Pack(decimals)
+	return ret, remainingGas, err
+}
+
+// tokenAddress returns the address emitting the events of the denom.
+func (c *bankContract) tokenAddress(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	if remainingGas, err = contract.DeductGas(suppliedGas, tokenAddressGasCost); err != nil {
+		return nil, 0, err
+	}
+	method := ABI.Methods["tokenAddress"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+
+	ret, err = method.Outputs.Pack(TokenAddress(args[0].(string)))
+	return ret, remainingGas, err
+}
+
+func (c *bankContract) allowance(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	if remainingGas, err = contract.DeductGas(suppliedGas, readGasCost); err != nil {
+		return nil, 0, err
+	}
+	method := ABI.Methods["allowance"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	denom, owner, spender := args[0].(string), args[1].(common.Address), args[2].(common.Address)
+
+	allowance := accessibleState.GetStateDB().GetState(Address, allowanceKey(denom, owner, spender))
+
+	ret, err = method.Outputs.Pack(allowance.Big())
+	return ret, remainingGas, err
+}
+
+func (c *bankContract) approve(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	if remainingGas, err = contract.DeductGas(suppliedGas, writeGasCost); err != nil {
+		return nil, 0, err
+	}
+	if readOnly {
+		return nil, remainingGas, vmerrs.ErrWriteProtection
+	}
+	method := ABI.Methods["approve"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	denom, spender, amount := args[0].(string), args[1].(common.Address), args[2].(*big.Int)
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, remainingGas, err
+	}
+
+	// the allowances are stored in the contract storage, the StateDB journals them
+	stateDB.SetState(Address, allowanceKey(denom, caller, spender), common.BigToHash(amount))
+	if err := precompiles.AddLog(stateDB, TokenAddress(denom), ABI.Events["Approval"], caller, spender, amount); err != nil {
+		return nil, remainingGas, err
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	return ret, remainingGas, err
+}
+
+func (c *bankContract) transfer(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	if remainingGas, err = contract.DeductGas(suppliedGas, 2*writeGasCost); err != nil {
+		return nil, 0, err
+	}
+	if readOnly {
+		return nil, remainingGas, vmerrs.ErrWriteProtection
+	}
+	method := ABI.Methods["transfer"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	denom, to, amount := args[0].(string), args[1].(common.Address), args[2].(*big.Int)
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	if remainingGas, err = c.send(stateDB, remainingGas, denom, caller, to, amount); err != nil {
+		return nil, remainingGas, err
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	return ret, remainingGas, err
+}
+
+// transferFrom transfers the coins of the owner, the caller spends its allowance
+// unless it's the owner.
+func (c *bankContract) transferFrom(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	if remainingGas, err = contract.DeductGas(suppliedGas, 3*writeGasCost); err != nil {
+		return nil, 0, err
+	}
+	if readOnly {
+		return nil, remainingGas, vmerrs.ErrWriteProtection
+	}
+	method := ABI.Methods["transferFrom"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	denom, from, to, amount := args[0].(string), args[1].(common.Address), args[2].(common.Address), args[3].(*big.Int)
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, remainingGas, err
+	}
+
+	if caller != from {
+		key := allowanceKey(denom, from, caller)
+		allowance := stateDB.GetState(Address, key).Big()
+		if allowance.Cmp(amount) < 0 {
+			return nil, remainingGas, fmt.Errorf("insufficient allowance: %s < %s", allowance, amount)
+		}
+		stateDB.SetState(Address, key, common.BigToHash(new(big.Int).Sub(allowance, amount)))
+	}
+	if remainingGas, err = c.send(stateDB, remainingGas, denom, from, to, amount); err != nil {
+		return nil, remainingGas, err
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	return ret, remainingGas, err
+}
+
+// send transfers the coins with the bank keeper, the transfer is reverted
+// along the EVM call. The EVM denom is transferred too, the EVM balances are
+// synced with the native ones around the action. The KV store reads and writes
+// of the transfer are charged to the supplied gas.
+func (c *bankContract) send(
+	stateDB statedb.ExtStateDB,
+	suppliedGas uint64,
+	denom string,
+	from, to common.Address,
+	amount *big.Int,
+) (remainingGas uint64, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return suppliedGas, err
+	}
+
+	remainingGas = suppliedGas
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(amount)))
+	if !coins.IsZero() {
+		if remainingGas, err = precompiles.RunNativeAction(stateDB, suppliedGas, func(ctx sdk.Context) error {
+			if err := c.bankKeeper.IsSendEnabledCoins(ctx, coins...); err != nil {
+				return err
+			}
+			if c.bankKeeper.BlockedAddr(to.Bytes()) {
+				return fmt.Errorf("%s is not allowed to receive funds", sdk.AccAddress(to.Bytes()))
+			}
+			return c.bankKeeper.SendCoins(ctx, from.Bytes(), to.Bytes(), coins)
+		}); err != nil {
+			return remainingGas, err
+		}
+	}
+
+	return remainingGas, precompiles.AddLog(stateDB, TokenAddress(denom), ABI.Events["Transfer"], from, to, amount)
+}
+
+// allowanceKey returns the storage key of the allowance of the spender.
+func allowanceKey(denom string, owner, spender common.Address) common.Hash {
+	return crypto.Keccak256Hash(owner.Bytes(), spender.Bytes(), []byte(denom))
+}
@@ -0,0 +1,212 @@
+package bank_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/vmerrs"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/precompiles/bank"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+const testDenom = "uatom"
+
+// setupTest returns a test whose sender owns 100 test coins, with the bank
+// precompile enabled if requested.
+func setupTest(t *testing.T, enabled bool) (*testutil.PrecompileTest, common.Address) {
+	var addrs []common.Address
+	if enabled {
+		addrs = append(addrs, bank.Address)
+	}
+	pt := testutil.NewPrecompileTest("", addrs...)
+
+	sender := tests.GenerateAddress()
+	require.NoError(t, testutil.FundAccount(pt.App.BankKeeper, pt.Ctx, sender.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100))))
+	pt.App.BankKeeper.SetDenomMetaData(pt.Ctx, banktypes.Metadata{
+		Base:    testDenom,
+		Display: "atom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: testDenom, Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+	})
+	pt.Reset()
+	return pt, sender
+}
+
+func TestBankPrecompile(t *testing.T) {
+	pt, sender := setupTest(t, true)
+	ethApp, ctx, stateDB, evm := pt.App, pt.Ctx, pt.StateDB, pt.EVM
+	recipient, spender := tests.GenerateAddress(), tests.GenerateAddress()
+	call := func(from common.Address, method string, args ...interface{}) ([]interface{}, error) {
+		return pt.Call(from, bank.Address, bank.ABI, method, args...)
+	}
+
+	balance := func(addr common.Address) int64 {
+		out, err := call(sender, "balanceOf", testDenom, addr)
+		require.NoError(t, err)
+		return out[0].(*big.Int).Int64()
+	}
+
+	require.Equal(t, int64(100), balance(sender))
+	out, err := call(sender, "totalSupply", testDenom)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), out[0].(*big.Int).Int64())
+	out, err = call(sender, "decimals", testDenom)
+	require.NoError(t, err)
+	require.Equal(t, uint8(6), out[0])
+
+	// transfer
+	_, err = call(sender, "transfer", testDenom, recipient, big.NewInt(30))
+	require.NoError(t, err)
+	require.Equal(t, int64(70), balance(sender))
+	require.Equal(t, int64(30), balance(recipient))
+	// the standard ERC-20 event, emitted by the token address of the denom
+	out, err = call(sender, "tokenAddress", testDenom)
+	require.NoError(t, err)
+	require.Equal(t, bank.TokenAddress(testDenom), out[0])
+	require.NotEqual(t, bank.TokenAddress(testDenom), bank.TokenAddress(evmtypes.DefaultEVMDenom))
+	logs := stateDB.Logs()
+	require.Len(t, logs, 1)
+	require.Equal(t, bank.TokenAddress(testDenom), logs[0].Address)
+	require.Len(t, logs[0].Topics, 3)
+	require.Equal(t, crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")), logs[0].Topics[0])
+	require.Equal(t, common.BytesToHash(sender.Bytes()), logs[0].Topics[1])
+	require.Equal(t, common.BytesToHash(recipient.Bytes()), logs[0].Topics[2])
+	require.Equal(t, common.BigToHash(big.NewInt(30)).Bytes(), logs[0].Data)
+
+	// the transfer is reverted along the EVM state, the account it created too
+	snapshot := stateDB.Snapshot()
+	newRecipient := tests.GenerateAddress()
+	_, err = call(sender, "transfer", testDenom, recipient, big.NewInt(10))
+	require.NoError(t, err)
+	_, err = call(sender, "transfer", testDenom, newRecipient, big.NewInt(5))
+	require.NoError(t, err)
+	require.Equal(t, int64(55), balance(sender))
+	require.NotNil(t, ethApp.AccountKeeper.GetAccount(stateDB.CacheContext(), newRecipient.Bytes()))
+	stateDB.RevertToSnapshot(snapshot)
+	require.Equal(t, int64(70), balance(sender))
+	require.Equal(t, int64(30), balance(recipient))
+	require.Equal(t, int64(0), balance(newRecipient))
+	require.Nil(t, ethApp.AccountKeeper.GetAccount(stateDB.CacheContext(), newRecipient.Bytes()))
+	require.Len(t, stateDB.Logs(), 1)
+
+	// invalid transfers
+	_, err = call(sender, "transfer", testDenom, recipient, big.NewInt(71))
+	require.Error(t, err)
+	_, err = call(sender, "transfer", "invalid denom", recipient, big.NewInt(1))
+	require.Error(t, err)
+	input, err := bank.ABI.Pack("transfer", testDenom, recipient, big.NewInt(1))
+	require.NoError(t, err)
+	_, _, err = evm.StaticCall(vm.AccountRef(sender), bank.Address, input, 1_000_000)
+	require.ErrorIs(t, err, vmerrs.ErrWriteProtection)
+
+	// allowance
+	_, err = call(sender, "approve", testDenom, spender, big.NewInt(20))
+	require.NoError(t, err)
+	out, err = call(sender, "allowance", testDenom, sender, spender)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), out[0].(*big.Int).Int64())
+	_, err = call(spender, "transferFrom", testDenom, sender, recipient, big.NewInt(15))
+	require.NoError(t, err)
+	_, err = call(spender, "transferFrom", testDenom, sender, recipient, big.NewInt(15))
+	require.Error(t, err)
+	out, err = call(sender, "allowance", testDenom, sender, spender)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), out[0].(*big.Int).Int64())
+	logs = stateDB.Logs()
+	require.Equal(t, bank.TokenAddress(testDenom), logs[1].Address)
+	require.Equal(t, crypto.Keccak256Hash([]byte("Approval(address,address,uint256)")), logs[1].Topics[0])
+	require.Equal(t, common.BytesToHash(sender.Bytes()), logs[1].Topics[1])
+	require.Equal(t, common.BytesToHash(spender.Bytes()), logs[1].Topics[2])
+	require.Equal(t, common.BigToHash(big.NewInt(20)).Bytes(), logs[1].Data)
+
+	// the coins are moved on commit only
+	require.Equal(t, int64(100), ethApp.BankKeeper.GetBalance(ctx, sender.Bytes(), testDenom).Amount.Int64())
+	require.NoError(t, stateDB.Commit())
+	require.Equal(t, int64(55), ethApp.BankKeeper.GetBalance(ctx, sender.Bytes(), testDenom).Amount.Int64())
+	require.Equal(t, int64(45), ethApp.BankKeeper.GetBalance(ctx, recipient.Bytes(), testDenom).Amount.Int64())
+}
+
+func TestBankPrecompileEVMDenom(t *testing.T) {
+	pt, sender := setupTest(t, true)
+	ethApp, ctx, stateDB := pt.App, pt.Ctx, pt.StateDB
+	recipient := tests.GenerateAddress()
+	denom := evmtypes.DefaultEVMDenom
+
+	// the EVM balances are synced with the native ones around the transfer
+	stateDB.AddBalance(sender, big.NewInt(50))
+	_, err := pt.Call(sender, bank.Address, bank.ABI, "transfer", denom, recipient, big.NewInt(20))
+	require.NoError(t, err)
+	require.Equal(t, int64(30), stateDB.GetBalance(sender).Int64())
+	require.Equal(t, int64(20), stateDB.GetBalance(recipient).Int64())
+	out, err := pt.Call(sender, bank.Address, bank.ABI, "balanceOf", denom, recipient)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), out[0].(*big.Int).Int64())
+	require.Equal(t, bank.TokenAddress(denom), stateDB.Logs()[0].Address)
+
+	// the EVM balance can't be overspent
+	_, err = pt.Call(sender, bank.Address, bank.ABI, "transfer", denom, recipient, big.NewInt(31))
+	require.Error(t, err)
+
+	require.NoError(t, stateDB.Commit())
+	require.Equal(t, int64(30), ethApp.BankKeeper.GetBalance(ctx, sender.Bytes(), denom).Amount.Int64())
+	require.Equal(t, int64(20), ethApp.BankKeeper.GetBalance(ctx, recipient.Bytes(), denom).Amount.Int64())
+}
+
+func TestBankPrecompileDisabled(t *testing.T) {
+	pt, sender := setupTest(t, false)
+	ethApp, ctx, stateDB, evm := pt.App, pt.Ctx, pt.StateDB, pt.EVM
+
+	input, err := bank.ABI.Pack("transfer", testDenom, tests.GenerateAddress(), big.NewInt(30))
+	require.NoError(t, err)
+	ret, leftOverGas, err := evm.Call(vm.AccountRef(sender), bank.Address, input, 1_000_000, big.NewInt(0))
+	require.NoError(t, err)
+	require.Empty(t, ret)
+	require.Equal(t, uint64(1_000_000), leftOverGas)
+
+	require.NoError(t, stateDB.Commit())
+	require.Equal(t, int64(100), ethApp.BankKeeper.GetBalance(ctx, sender.Bytes(), testDenom).Amount.Int64())
+}
+
+func TestBankPrecompileNativeActions(t *testing.T) {
+	pt, sender := setupTest(t, true)
+	stateDB, evm := pt.StateDB, pt.EVM
+	recipient := tests.GenerateAddress()
+	transfer := func() (uint64, error) {
+		input, err := bank.ABI.Pack("transfer", testDenom, recipient, big.NewInt(1))
+		require.NoError(t, err)
+		_, leftOverGas, err := evm.Call(vm.AccountRef(sender), bank.Address, input, testutil.PrecompileTestGas, big.NewInt(0))
+		return testutil.PrecompileTestGas - leftOverGas, err
+	}
+
+	// the balances of the EVM accounts synced to the native state are charged
+	gasUsed, err := transfer()
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		stateDB.AddBalance(tests.GenerateAddress(), big.NewInt(1))
+	}
+	syncGasUsed, err := transfer()
+	require.NoError(t, err)
+	require.Greater(t, syncGasUsed, gasUsed)
+
+	// the reverted actions count too
+	snapshot := stateDB.Snapshot()
+	for i := 2; i < statedb.MaxNativeActions; i++ {
+		_, err = transfer()
+		require.NoError(t, err)
+	}
+	stateDB.RevertToSnapshot(snapshot)
+	_, err = transfer()
+	require.ErrorIs(t, err, statedb.ErrMaxNativeActions)
+}
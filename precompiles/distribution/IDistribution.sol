@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.0;
+
+/// @dev The distribution precompiled contract, at 0x0000000000000000000000000000000000000801.
+/// The delegator is msg.sender and the validators are given by their operator address.
+interface IDistribution {
+    struct Coin {
+        string denom;
+        uint256 amount;
+    }
+
+    event WithdrawRewards(address indexed delegator, string validator);
+    event SetWithdrawAddress(address indexed delegator, address indexed withdrawAddress);
+
+    /// @dev Sends the rewards of the delegation to the withdraw address and returns them.
+    function withdrawRewards(string calldata validator) external returns (Coin[] memory amount);
+
+    function setWithdrawAddress(address withdrawAddress) external returns (bool);
+
+    function rewards(address delegator, string calldata validator) external view returns (Coin[] memory);
+}
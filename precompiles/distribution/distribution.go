@@ -0,0 +1,213 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package distribution
+
+import (
+	_ "embed"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/ethereum/go-ethereum/vmerrs"
+
+	"github.com/evmos/ethermint/precompiles"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+var (
+	// Address is the address of the distribution precompiled contract.
+	Address = common.HexToAddress("0x0000000000000000000000000000000000000801")
+
+	// RawABI is the ABI of the distribution precompiled contract.
+	//go:embed IDistribution.abi
+	RawABI string
+	// ABI is the parsed ABI of the distribution precompiled contract.
+	ABI = contract.MustParseABI(RawABI)
+)
+
+func init() {
+	statedb.RegisterPrecompile(Address)
+}
+
+// distributionContract manages the rewards of the delegations of the callers
+// through the x/distribution messages, the gas is the one consumed by the
+// messages.
+type distributionContract struct {
+	msgServer distrtypes.MsgServer
+	querier   distrkeeper.Querier
+}
+
+// NewContract creates the distribution precompiled contract, run at Address
+// once it's enabled in the EVM params.
+func NewContract(distrKeeper distrkeeper.Keeper) contract.StatefulPrecompiledContract {
+	c := &distributionContract{
+		msgServer: distrkeeper.NewMsgServerImpl(distrKeeper),
+		querier:   distrkeeper.NewQuerier(distrKeeper),
+	}
+
+	var functions []*contract.StatefulPrecompileFunction
+	for name, run := range map[string]contract.RunStatefulPrecompileFunc{
+		"withdrawRewards":    c.withdrawRewards,
+		"setWithdrawAddress": c.setWithdrawAddress,
+		"rewards":            c.rewards,
+	} {
+		functions = append(functions, contract.NewStatefulPrecompileFunction(ABI.Methods[name].ID, run))
+	}
+
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+	if err != nil {
+		panic(err)
+	}
+	return precompile
+}
+
+func (c *distributionContract) withdrawRewards(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	if readOnly {
+		return nil, suppliedGas, vmerrs.ErrWriteProtection
+	}
+	method := ABI.Methods["withdrawRewards"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+	validator := args[0].(string)
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+
+	var amount sdk.Coins
+	remainingGas, err = precompiles.RunNativeAction(stateDB, suppliedGas, func(ctx sdk.Context) error {
+		msg := &distrtypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: sdk.AccAddress(caller.Bytes()).String(),
+			ValidatorAddress: validator,
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		res, err := c.msgServer.WithdrawDelegatorReward(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return err
+		}
+		amount = res.Amount
+		return nil
+	})
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	if err := precompiles.AddLog(stateDB, Address, ABI.Events["WithdrawRewards"], caller, validator); err != nil {
+		return nil, remainingGas, err
+	}
+
+	ret, err = method.Outputs.Pack(precompiles.NewCoins(amount))
+	return ret, remainingGas, err
+}
+
+func (c *distributionContract) setWithdrawAddress(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	if readOnly {
+		return nil, suppliedGas, vmerrs.ErrWriteProtection
+	}
+	method := ABI.Methods["setWithdrawAddress"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+	withdrawAddress := args[0].(common.Address)
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+
+	remainingGas, err = precompiles.RunNativeAction(stateDB, suppliedGas, func(ctx sdk.Context) error {
+		msg := &distrtypes.MsgSetWithdrawAddress{
+			DelegatorAddress: sdk.AccAddress(caller.Bytes()).String(),
+			WithdrawAddress:  sdk.AccAddress(withdrawAddress.Bytes()).String(),
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		_, err := c.msgServer.SetWithdrawAddress(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	if err := precompiles.AddLog(stateDB, Address, ABI.Events["SetWithdrawAddress"], caller, withdrawAddress); err != nil {
+		return nil, remainingGas, err
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	return ret, remainingGas, err
+}
+
+// rewards returns the rewards of the delegation, truncated to integer amounts.
+func (c *distributionContract) rewards(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	method := ABI.Methods["rewards"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+	delegator, validator := args[0].(common.Address), args[1].(string)
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+
+	var rewards sdk.Coins
+	remainingGas, err = precompiles.RunNativeQuery(stateDB, suppliedGas, func(ctx sdk.Context) error {
+		res, err := c.querier.DelegationRewards(sdk.WrapSDKContext(ctx), &distrtypes.QueryDelegationRewardsRequest{
+			DelegatorAddress: sdk.AccAddress(delegator.Bytes()).String(),
+			ValidatorAddress: validator,
+		})
+		if err != nil {
+			return err
+		}
+		rewards, _ = res.Rewards.TruncateDecimal()
+		return nil
+	})
+	if err != nil {
+		return nil, remainingGas, err
+	}
+
+	ret, err = method.Outputs.Pack(precompiles.NewCoins(rewards))
+	return ret, remainingGas, err
+}
@@ -0,0 +1,72 @@
+package distribution_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/precompiles"
+	"github.com/evmos/ethermint/precompiles/distribution"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil"
+)
+
+func TestDistributionPrecompile(t *testing.T) {
+	pt := testutil.NewPrecompileTest("", distribution.Address)
+	delegator, withdrawer := tests.GenerateAddress(), tests.GenerateAddress()
+	validator := pt.App.StakingKeeper.GetAllValidators(pt.Ctx)[0]
+
+	// the delegator has half of the shares of the validator
+	amount := validator.Tokens
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+	require.NoError(t, testutil.FundAccount(pt.App.BankKeeper, pt.Ctx, delegator.Bytes(), coins))
+	_, err := pt.App.StakingKeeper.Delegate(pt.Ctx, delegator.Bytes(), amount, stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	validator, _ = pt.App.StakingKeeper.GetValidator(pt.Ctx, validator.GetOperator())
+
+	// the delegation gets rewards from the next block
+	pt.Ctx = pt.Ctx.WithBlockHeight(pt.Ctx.BlockHeight() + 1)
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+	require.NoError(t, testutil.FundModuleAccount(pt.App.BankKeeper, pt.Ctx, distrtypes.ModuleName, rewards))
+	pt.App.DistrKeeper.AllocateTokensToValidator(pt.Ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+	pt.Reset()
+
+	call := func(method string, args ...interface{}) ([]interface{}, error) {
+		return pt.Call(delegator, distribution.Address, distribution.ABI, method, args...)
+	}
+	pending := func() []precompiles.Coin {
+		out, err := call("rewards", delegator, validator.OperatorAddress)
+		require.NoError(t, err)
+		return *abi.ConvertType(out[0], new([]precompiles.Coin)).(*[]precompiles.Coin)
+	}
+
+	expRewards := []precompiles.Coin{{Denom: sdk.DefaultBondDenom, Amount: big.NewInt(500)}}
+	require.Equal(t, expRewards, pending())
+
+	_, err = call("setWithdrawAddress", withdrawer)
+	require.NoError(t, err)
+
+	// the withdrawal is reverted along the EVM state
+	snapshot := pt.StateDB.Snapshot()
+	out, err := call("withdrawRewards", validator.OperatorAddress)
+	require.NoError(t, err)
+	require.Equal(t, expRewards, *abi.ConvertType(out[0], new([]precompiles.Coin)).(*[]precompiles.Coin))
+	require.Empty(t, pending())
+	pt.StateDB.RevertToSnapshot(snapshot)
+	require.Equal(t, expRewards, pending())
+
+	_, err = call("withdrawRewards", validator.OperatorAddress)
+	require.NoError(t, err)
+	_, err = call("withdrawRewards", "invalid")
+	require.Error(t, err)
+	require.Len(t, pt.StateDB.Logs(), 2)
+
+	require.NoError(t, pt.StateDB.Commit())
+	require.Equal(t, int64(500), pt.App.BankKeeper.GetBalance(pt.Ctx, withdrawer.Bytes(), sdk.DefaultBondDenom).Amount.Int64())
+}
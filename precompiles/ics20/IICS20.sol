@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.0;
+
+/// @dev The ICS-20 precompiled contract, at 0x0000000000000000000000000000000000000802.
+/// The coins are transferred from msg.sender, contract or EOA.
+interface IICS20 {
+    struct Height {
+        uint64 revisionNumber;
+        uint64 revisionHeight;
+    }
+
+    struct DenomTrace {
+        string path;
+        string baseDenom;
+    }
+
+    event IBCTransfer(
+        address indexed sender,
+        string receiver,
+        string sourcePort,
+        string sourceChannel,
+        string denom,
+        uint256 amount,
+        uint64 sequence,
+        string memo
+    );
+
+    /// @dev Sends a fungible token packet and returns its sequence. The timeout
+    /// height or timestamp may be zero, not both.
+    function transfer(
+        string calldata sourcePort,
+        string calldata sourceChannel,
+        string calldata denom,
+        uint256 amount,
+        string calldata receiver,
+        Height calldata timeoutHeight,
+        uint64 timeoutTimestamp,
+        string calldata memo
+    ) external returns (uint64 sequence);
+
+    /// @dev Returns the sequences of the packets sent on the channel which are
+    /// neither acknowledged nor timed out.
+    function outstandingPackets(
+        string calldata sourcePort,
+        string calldata sourceChannel
+    ) external view returns (uint64[] memory sequences);
+
+    /// @dev Returns the trace of the ibc/{hash} denom, the hash alone is accepted.
+    function denomTrace(string calldata denom) external view returns (DenomTrace memory trace);
+}
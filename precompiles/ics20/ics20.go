@@ -0,0 +1,244 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ics20
+
+import (
+	_ "embed"
+	"errors"
+	"math/big"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v7/modules/apps/transfer/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channelkeeper "github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/ethereum/go-ethereum/vmerrs"
+
+	"github.com/evmos/ethermint/precompiles"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+var (
+	// Address is the address of the ICS-20 precompiled contract.
+	Address = common.HexToAddress("0x0000000000000000000000000000000000000802")
+
+	// RawABI is the ABI of the ICS-20 precompiled contract.
+	//go:embed IICS20.abi
+	RawABI string
+	// ABI is the parsed ABI of the ICS-20 precompiled contract.
+	ABI = contract.MustParseABI(RawABI)
+)
+
+// ErrDenomTraceNotFound is returned when the denom trace of an IBC denom is
+// unknown.
+var ErrDenomTraceNotFound = errors.New("denom trace not found")
+
+func init() {
+	statedb.RegisterPrecompile(Address)
+}
+
+// Height is the ABI representation of an IBC height.
+type Height struct {
+	RevisionNumber uint64
+	RevisionHeight uint64
+}
+
+// DenomTrace is the ABI representation of the trace of an IBC denom.
+type DenomTrace struct {
+	Path      string
+	BaseDenom string
+}
+
+// ics20Contract sends the coins of the callers to other chains through the
+// ICS-20 transfer keeper, the gas is the one consumed by the transfers.
+type ics20Contract struct {
+	transferKeeper ibctransferkeeper.Keeper
+	channelKeeper  channelkeeper.Keeper
+}
+
+// NewContract creates the ICS-20 precompiled contract, run at Address once
+// it's enabled in the EVM params.
+func NewContract(
+	transferKeeper ibctransferkeeper.Keeper,
+	channelKeeper channelkeeper.Keeper,
+) contract.StatefulPrecompiledContract {
+	c := &ics20Contract{
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+	}
+
+	var functions []*contract.StatefulPrecompileFunction
+	for name, run := range map[string]contract.RunStatefulPrecompileFunc{
+		"transfer":           c.transfer,
+		"outstandingPackets": c.outstandingPackets,
+		"denomTrace":         c.denomTrace,
+	} {
+		functions = append(functions, contract.NewStatefulPrecompileFunction(ABI.Methods[name].ID, run))
+	}
+
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+	if err != nil {
+		panic(err)
+	}
+	return precompile
+}
+
+func (c *ics20Contract) transfer(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	if readOnly {
+		return nil, suppliedGas, vmerrs.ErrWriteProtection
+	}
+	method := ABI.Methods["transfer"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+	sourcePort, sourceChannel, denom := args[0].(string), args[1].(string), args[2].(string)
+	amount, receiver := args[3].(*big.Int), args[4].(string)
+	timeoutHeight := *abi.ConvertType(args[5], new(Height)).(*Height)
+	timeoutTimestamp, memo := args[6].(uint64), args[7].(string)
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+
+	var sequence uint64
+	remainingGas, err = precompiles.RunNativeAction(stateDB, suppliedGas, func(ctx sdk.Context) error {
+		msg := ibctransfertypes.NewMsgTransfer(
+			sourcePort,
+			sourceChannel,
+			// unlike sdk.NewCoin, the invalid coins are rejected by ValidateBasic
+			sdk.Coin{Denom: denom, Amount: sdkmath.NewIntFromBigInt(amount)},
+			sdk.AccAddress(caller.Bytes()).String(),
+			receiver,
+			clienttypes.NewHeight(timeoutHeight.RevisionNumber, timeoutHeight.RevisionHeight),
+			timeoutTimestamp,
+			memo,
+		)
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		res, err := c.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return err
+		}
+		sequence = res.Sequence
+		return nil
+	})
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	if err := precompiles.AddLog(
+		stateDB, Address, ABI.Events["IBCTransfer"],
+		caller, receiver, sourcePort, sourceChannel, denom, amount, sequence, memo,
+	); err != nil {
+		return nil, remainingGas, err
+	}
+
+	ret, err = method.Outputs.Pack(sequence)
+	return ret, remainingGas, err
+}
+
+// outstandingPackets returns the sequences of the packets of the channel
+// which still have a commitment, they are removed once the packets are
+// acknowledged or timed out.
+func (c *ics20Contract) outstandingPackets(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	method := ABI.Methods["outstandingPackets"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+	sourcePort, sourceChannel := args[0].(string), args[1].(string)
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+
+	sequences := []uint64{}
+	remainingGas, err = precompiles.RunNativeQuery(stateDB, suppliedGas, func(ctx sdk.Context) error {
+		for _, commitment := range c.channelKeeper.GetAllPacketCommitmentsAtChannel(ctx, sourcePort, sourceChannel) {
+			sequences = append(sequences, commitment.Sequence)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, remainingGas, err
+	}
+
+	ret, err = method.Outputs.Pack(sequences)
+	return ret, remainingGas, err
+}
+
+// denomTrace returns the trace of an ibc/{hash} denom, or of the hash alone.
+func (c *ics20Contract) denomTrace(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	method := ABI.Methods["denomTrace"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(args[0].(string), ibctransfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+
+	var trace ibctransfertypes.DenomTrace
+	remainingGas, err = precompiles.RunNativeQuery(stateDB, suppliedGas, func(ctx sdk.Context) error {
+		var found bool
+		trace, found = c.transferKeeper.GetDenomTrace(ctx, hash)
+		if !found {
+			return ErrDenomTraceNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, remainingGas, err
+	}
+
+	ret, err = method.Outputs.Pack(DenomTrace{Path: trace.Path, BaseDenom: trace.BaseDenom})
+	return ret, remainingGas, err
+}
@@ -0,0 +1,148 @@
+package ics20_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/vmerrs"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/precompiles/ics20"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil"
+)
+
+func TestICS20Precompile(t *testing.T) {
+	pt := testutil.NewPrecompileTest("", ics20.Address)
+	sender := tests.GenerateAddress()
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+	require.NoError(t, testutil.FundAccount(pt.App.BankKeeper, pt.Ctx, sender.Bytes(), coins))
+
+	trace := ibctransfertypes.ParseDenomTrace("transfer/channel-0/uatom")
+	pt.App.TransferKeeper.SetDenomTrace(pt.Ctx, trace)
+	pt.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(pt.Ctx, "transfer", "channel-0", 2, []byte("commitment"))
+	pt.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(pt.Ctx, "transfer", "channel-0", 5, []byte("commitment"))
+	pt.Reset()
+
+	call := func(method string, args ...interface{}) ([]interface{}, error) {
+		return pt.Call(sender, ics20.Address, ics20.ABI, method, args...)
+	}
+
+	// the denom trace is found by IBC denom or by hash
+	for _, denom := range []string{trace.IBCDenom(), trace.Hash().String()} {
+		out, err := call("denomTrace", denom)
+		require.NoError(t, err)
+		require.Equal(t, ics20.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}, *abi.ConvertType(out[0], new(ics20.DenomTrace)).(*ics20.DenomTrace))
+	}
+	_, err := call("denomTrace", ibctransfertypes.ParseDenomTrace("transfer/channel-1/uatom").IBCDenom())
+	require.ErrorIs(t, err, ics20.ErrDenomTraceNotFound)
+	_, err = call("denomTrace", "invalid")
+	require.Error(t, err)
+
+	out, err := call("outstandingPackets", "transfer", "channel-0")
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 5}, out[0])
+	out, err = call("outstandingPackets", "transfer", "channel-1")
+	require.NoError(t, err)
+	require.Empty(t, out[0])
+
+	// the test app has no channel, the transfers fail without moving the coins
+	transferArgs := []interface{}{
+		"transfer", "channel-0", sdk.DefaultBondDenom, big.NewInt(100), "cosmos1receiver",
+		ics20.Height{RevisionNumber: 1, RevisionHeight: 100}, uint64(0), "",
+	}
+	_, err = call("transfer", transferArgs...)
+	require.ErrorIs(t, err, channeltypes.ErrChannelNotFound)
+	require.Empty(t, pt.StateDB.Logs())
+
+	input, err := ics20.ABI.Pack("transfer", transferArgs...)
+	require.NoError(t, err)
+	_, _, err = pt.EVM.StaticCall(vm.AccountRef(sender), ics20.Address, input, testutil.PrecompileTestGas)
+	require.ErrorIs(t, err, vmerrs.ErrWriteProtection)
+
+	require.NoError(t, pt.StateDB.Commit())
+	require.Equal(t, coins, pt.App.BankKeeper.GetAllBalances(pt.Ctx, sender.Bytes()))
+}
+
+// openTransferChannel opens a transfer channel on the localhost connection,
+// owned by the transfer module.
+func openTransferChannel(t *testing.T, pt *testutil.PrecompileTest, channelID string) {
+	channel := channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(ibctransfertypes.PortID, "channel-1"),
+		[]string{ibcexported.LocalhostConnectionID}, ibctransfertypes.Version,
+	)
+	pt.App.IBCKeeper.ChannelKeeper.SetChannel(pt.Ctx, ibctransfertypes.PortID, channelID, channel)
+	pt.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(pt.Ctx, ibctransfertypes.PortID, channelID, 1)
+
+	capPath := host.ChannelCapabilityPath(ibctransfertypes.PortID, channelID)
+	channelCap, err := pt.App.ScopedIBCKeeper.NewCapability(pt.Ctx, capPath)
+	require.NoError(t, err)
+	require.NoError(t, pt.App.ScopedTransferKeeper.ClaimCapability(pt.Ctx, channelCap, capPath))
+}
+
+func TestICS20PrecompileTransfer(t *testing.T) {
+	pt := testutil.NewPrecompileTest("", ics20.Address)
+	sender := tests.GenerateAddress()
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+	require.NoError(t, testutil.FundAccount(pt.App.BankKeeper, pt.Ctx, sender.Bytes(), coins))
+	openTransferChannel(t, pt, "channel-0")
+	pt.Reset()
+
+	escrow := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+	transfer := func(amount int64) ([]interface{}, error) {
+		return pt.Call(sender, ics20.Address, ics20.ABI, "transfer",
+			ibctransfertypes.PortID, "channel-0", sdk.DefaultBondDenom, big.NewInt(amount), "cosmos1receiver",
+			ics20.Height{RevisionNumber: 1, RevisionHeight: 100}, uint64(0), "memo",
+		)
+	}
+	commitment := func(sequence uint64) []byte {
+		return pt.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(pt.StateDB.CacheContext(), ibctransfertypes.PortID, "channel-0", sequence)
+	}
+
+	// the transfer returns the sequence of its packet and escrows the coins
+	out, err := transfer(100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), out[0])
+	require.NotEmpty(t, commitment(1))
+	require.Equal(t, int64(100), pt.App.BankKeeper.GetBalance(pt.StateDB.CacheContext(), escrow, sdk.DefaultBondDenom).Amount.Int64())
+
+	logs := pt.StateDB.Logs()
+	require.Len(t, logs, 1)
+	require.Equal(t, ics20.Address, logs[0].Address)
+	require.Equal(t, ics20.ABI.Events["IBCTransfer"].ID, logs[0].Topics[0])
+	require.Equal(t, common.BytesToHash(sender.Bytes()), logs[0].Topics[1])
+	data, err := ics20.ABI.Unpack("IBCTransfer", logs[0].Data)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		"cosmos1receiver", ibctransfertypes.PortID, "channel-0", sdk.DefaultBondDenom, big.NewInt(100), uint64(1), "memo",
+	}, data)
+
+	// the packet commitment and the escrow are dropped on revert
+	snapshot := pt.StateDB.Snapshot()
+	out, err = transfer(200)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), out[0])
+	require.NotEmpty(t, commitment(2))
+	pt.StateDB.RevertToSnapshot(snapshot)
+	require.Empty(t, commitment(2))
+	require.Len(t, pt.StateDB.Logs(), 1)
+
+	require.NoError(t, pt.StateDB.Commit())
+	require.NotEmpty(t, pt.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(pt.Ctx, ibctransfertypes.PortID, "channel-0", 1))
+	require.Empty(t, pt.App.IBCKeeper.ChannelKeeper.GetPacketCommitment(pt.Ctx, ibctransfertypes.PortID, "channel-0", 2))
+	sequence, found := pt.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(pt.Ctx, ibctransfertypes.PortID, "channel-0")
+	require.True(t, found)
+	require.Equal(t, uint64(2), sequence)
+	require.Equal(t, int64(100), pt.App.BankKeeper.GetBalance(pt.Ctx, escrow, sdk.DefaultBondDenom).Amount.Int64())
+	require.Equal(t, int64(900), pt.App.BankKeeper.GetBalance(pt.Ctx, sender.Bytes(), sdk.DefaultBondDenom).Amount.Int64())
+}
@@ -0,0 +1,137 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+// Package precompiles contains the helpers shared by the stateful precompiled
+// contracts of the app, implemented in its subpackages.
+package precompiles
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// GetExtStateDB returns the StateDB of the EVM, the contracts need its
+// extensions to access the native state.
+func GetExtStateDB(accessibleState contract.AccessibleState) (statedb.ExtStateDB, error) {
+	stateDB, ok := accessibleState.GetStateDB().(statedb.ExtStateDB)
+	if !ok {
+		return nil, errors.New("the precompiled contract is not supported by the StateDB")
+	}
+	return stateDB, nil
+}
+
+// RunNativeAction runs the native action of a contract through
+// ExecuteNativeAction, and charges the gas consumed by the KV store reads and
+// writes of the action, and of the sync of the EVM balances, to the supplied
+// gas.
+func RunNativeAction(
+	stateDB statedb.ExtStateDB,
+	suppliedGas uint64,
+	action func(ctx sdk.Context) error,
+) (remainingGas uint64, err error) {
+	return meterGas(suppliedGas, func(gasMeter sdk.GasMeter) error {
+		return stateDB.ExecuteNativeAction(gasMeter, action)
+	})
+}
+
+// RunNativeQuery runs the native query of a contract on a branch of the cache
+// context, discarded afterwards, and charges the gas consumed by its KV store
+// reads to the supplied gas.
+func RunNativeQuery(
+	stateDB statedb.ExtStateDB,
+	suppliedGas uint64,
+	query func(ctx sdk.Context) error,
+) (remainingGas uint64, err error) {
+	return meterGas(suppliedGas, func(gasMeter sdk.GasMeter) error {
+		ctx, _ := stateDB.CacheContext().CacheContext()
+		return query(ctx.WithGasMeter(gasMeter))
+	})
+}
+
+// meterGas runs the function with a gas meter limited to the supplied gas, and
+// returns the remaining gas. Running out of gas consumes all the supplied gas.
+func meterGas(suppliedGas uint64, fn func(gasMeter sdk.GasMeter) error) (remainingGas uint64, err error) {
+	gasMeter := sdk.NewGasMeter(suppliedGas)
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			remainingGas, err = 0, vm.ErrOutOfGas
+		}
+	}()
+
+	err = fn(gasMeter)
+	return suppliedGas - gasMeter.GasConsumedToLimit(), err
+}
+
+// AddLog adds the log of an event of the contract at the address. The
+// arguments are the values of the event inputs, in the order of the ABI.
+func AddLog(stateDB statedb.ExtStateDB, address common.Address, event abi.Event, args ...interface{}) error {
+	if len(args) != len(event.Inputs) {
+		return fmt.Errorf("event %s: expected %d arguments, got %d", event.Name, len(event.Inputs), len(args))
+	}
+
+	topics := []common.Hash{event.ID}
+	var data []interface{}
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			data = append(data, args[i])
+			continue
+		}
+		topic, err := abi.MakeTopics([]interface{}{args[i]})
+		if err != nil {
+			return err
+		}
+		topics = append(topics, topic[0][0])
+	}
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     address,
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(stateDB.CacheContext().BlockHeight()),
+	})
+	return nil
+}
+
+// Coin is the ABI representation of a native coin.
+type Coin struct {
+	Denom  string
+	Amount *big.Int
+}
+
+// NewCoins converts the native coins to their ABI representation.
+func NewCoins(coins sdk.Coins) []Coin {
+	abiCoins := make([]Coin, len(coins))
+	for i, coin := range coins {
+		abiCoins[i] = Coin{Denom: coin.Denom, Amount: coin.Amount.BigInt()}
+	}
+	return abiCoins
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.0;
+
+/// @dev The staking precompiled contract, at 0x0000000000000000000000000000000000000800.
+/// The delegator is msg.sender, the validators are given by their operator
+/// address and the amounts are in the bond denom.
+interface IStaking {
+    event Delegate(address indexed delegator, string validator, uint256 amount);
+    event Undelegate(address indexed delegator, string validator, uint256 amount, int64 completionTime);
+    event Redelegate(
+        address indexed delegator,
+        string srcValidator,
+        string dstValidator,
+        uint256 amount,
+        int64 completionTime
+    );
+
+    function delegate(string calldata validator, uint256 amount) external returns (bool);
+
+    /// @dev Returns the unix time the undelegated coins are received at.
+    function undelegate(string calldata validator, uint256 amount) external returns (int64 completionTime);
+
+    /// @dev Returns the unix time the redelegation completes at.
+    function redelegate(
+        string calldata srcValidator,
+        string calldata dstValidator,
+        uint256 amount
+    ) external returns (int64 completionTime);
+
+    /// @dev Returns the shares of the delegation, with 18 decimals, and the amount they're worth.
+    function delegation(address delegator, string calldata validator) external view returns (uint256 shares, uint256 balance);
+}
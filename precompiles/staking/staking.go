@@ -0,0 +1,282 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package staking
+
+import (
+	_ "embed"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/ethereum/go-ethereum/vmerrs"
+
+	"github.com/evmos/ethermint/precompiles"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+var (
+	// Address is the address of the staking precompiled contract.
+	Address = common.HexToAddress("0x0000000000000000000000000000000000000800")
+
+	// RawABI is the ABI of the staking precompiled contract.
+	//go:embed IStaking.abi
+	RawABI string
+	// ABI is the parsed ABI of the staking precompiled contract.
+	ABI = contract.MustParseABI(RawABI)
+)
+
+func init() {
+	statedb.RegisterPrecompile(Address)
+}
+
+// stakingContract delegates the coins of the callers through the x/staking
+// messages, the gas is the one consumed by the messages.
+type stakingContract struct {
+	stakingKeeper *stakingkeeper.Keeper
+	msgServer     stakingtypes.MsgServer
+}
+
+// NewContract creates the staking precompiled contract, run at Address once
+// it's enabled in the EVM params.
+func NewContract(stakingKeeper *stakingkeeper.Keeper) contract.StatefulPrecompiledContract {
+	c := &stakingContract{
+		stakingKeeper: stakingKeeper,
+		msgServer:     stakingkeeper.NewMsgServerImpl(stakingKeeper),
+	}
+
+	var functions []*contract.StatefulPrecompileFunction
+	for name, run := range map[string]contract.RunStatefulPrecompileFunc{
+		"delegate":   c.delegate,
+		"undelegate": c.undelegate,
+		"redelegate": c.redelegate,
+		"delegation": c.delegation,
+	} {
+		functions = append(functions, contract.NewStatefulPrecompileFunction(ABI.Methods[name].ID, run))
+	}
+
+	precompile, err := contract.NewStatefulPrecompileContract(functions)
+	if err != nil {
+		panic(err)
+	}
+	return precompile
+}
+
+func (c *stakingContract) delegate(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	if readOnly {
+		return nil, suppliedGas, vmerrs.ErrWriteProtection
+	}
+	method := ABI.Methods["delegate"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+	validator, amount := args[0].(string), args[1].(*big.Int)
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+
+	remainingGas, err = precompiles.RunNativeAction(stateDB, suppliedGas, func(ctx sdk.Context) error {
+		msg := &stakingtypes.MsgDelegate{
+			DelegatorAddress: sdk.AccAddress(caller.Bytes()).String(),
+			ValidatorAddress: validator,
+			Amount:           c.bondCoin(ctx, amount),
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		_, err := c.msgServer.Delegate(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	if err := precompiles.AddLog(stateDB, Address, ABI.Events["Delegate"], caller, validator, amount); err != nil {
+		return nil, remainingGas, err
+	}
+
+	ret, err = method.Outputs.Pack(true)
+	return ret, remainingGas, err
+}
+
+func (c *stakingContract) undelegate(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	if readOnly {
+		return nil, suppliedGas, vmerrs.ErrWriteProtection
+	}
+	method := ABI.Methods["undelegate"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+	validator, amount := args[0].(string), args[1].(*big.Int)
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+
+	var completionTime int64
+	remainingGas, err = precompiles.RunNativeAction(stateDB, suppliedGas, func(ctx sdk.Context) error {
+		msg := &stakingtypes.MsgUndelegate{
+			DelegatorAddress: sdk.AccAddress(caller.Bytes()).String(),
+			ValidatorAddress: validator,
+			Amount:           c.bondCoin(ctx, amount),
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		res, err := c.msgServer.Undelegate(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return err
+		}
+		completionTime = res.CompletionTime.Unix()
+		return nil
+	})
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	if err := precompiles.AddLog(stateDB, Address, ABI.Events["Undelegate"], caller, validator, amount, completionTime); err != nil {
+		return nil, remainingGas, err
+	}
+
+	ret, err = method.Outputs.Pack(completionTime)
+	return ret, remainingGas, err
+}
+
+func (c *stakingContract) redelegate(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	if readOnly {
+		return nil, suppliedGas, vmerrs.ErrWriteProtection
+	}
+	method := ABI.Methods["redelegate"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+	srcValidator, dstValidator, amount := args[0].(string), args[1].(string), args[2].(*big.Int)
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+
+	var completionTime int64
+	remainingGas, err = precompiles.RunNativeAction(stateDB, suppliedGas, func(ctx sdk.Context) error {
+		msg := &stakingtypes.MsgBeginRedelegate{
+			DelegatorAddress:    sdk.AccAddress(caller.Bytes()).String(),
+			ValidatorSrcAddress: srcValidator,
+			ValidatorDstAddress: dstValidator,
+			Amount:              c.bondCoin(ctx, amount),
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		res, err := c.msgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return err
+		}
+		completionTime = res.CompletionTime.Unix()
+		return nil
+	})
+	if err != nil {
+		return nil, remainingGas, err
+	}
+	if err := precompiles.AddLog(
+		stateDB, Address, ABI.Events["Redelegate"], caller, srcValidator, dstValidator, amount, completionTime,
+	); err != nil {
+		return nil, remainingGas, err
+	}
+
+	ret, err = method.Outputs.Pack(completionTime)
+	return ret, remainingGas, err
+}
+
+// delegation returns zero shares and balance if the delegation doesn't exist.
+func (c *stakingContract) delegation(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) (ret []byte, remainingGas uint64, err error) {
+	method := ABI.Methods["delegation"]
+	args, err := method.Inputs.Unpack(input)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+	delegator, validator := args[0].(common.Address), args[1].(string)
+
+	stateDB, err := precompiles.GetExtStateDB(accessibleState)
+	if err != nil {
+		return nil, suppliedGas, err
+	}
+
+	shares, balance := new(big.Int), new(big.Int)
+	remainingGas, err = precompiles.RunNativeQuery(stateDB, suppliedGas, func(ctx sdk.Context) error {
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return err
+		}
+		val, found := c.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return nil
+		}
+		delegation, found := c.stakingKeeper.GetDelegation(ctx, delegator.Bytes(), valAddr)
+		if !found {
+			return nil
+		}
+		shares = delegation.Shares.BigInt()
+		balance = val.TokensFromShares(delegation.Shares).TruncateInt().BigInt()
+		return nil
+	})
+	if err != nil {
+		return nil, remainingGas, err
+	}
+
+	ret, err = method.Outputs.Pack(shares, balance)
+	return ret, remainingGas, err
+}
+
+// bondCoin returns the amount of the bond denom.
+func (c *stakingContract) bondCoin(ctx sdk.Context, amount *big.Int) sdk.Coin {
+	return sdk.NewCoin(c.stakingKeeper.BondDenom(ctx), sdkmath.NewIntFromBigInt(amount))
+}
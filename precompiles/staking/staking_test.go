@@ -0,0 +1,82 @@
+package staking_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/vmerrs"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/precompiles/staking"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil"
+)
+
+func TestStakingPrecompile(t *testing.T) {
+	// the bond denom is the EVM denom, the delegations change the EVM balances
+	pt := testutil.NewPrecompileTest(sdk.DefaultBondDenom, staking.Address)
+	delegator := tests.GenerateAddress()
+	require.NoError(t, testutil.FundAccount(pt.App.BankKeeper, pt.Ctx, delegator.Bytes(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))))
+	pt.Reset()
+	validator := pt.App.StakingKeeper.GetAllValidators(pt.Ctx)[0].OperatorAddress
+
+	call := func(method string, args ...interface{}) ([]interface{}, error) {
+		return pt.Call(delegator, staking.Address, staking.ABI, method, args...)
+	}
+	delegation := func() int64 {
+		out, err := call("delegation", delegator, validator)
+		require.NoError(t, err)
+		return out[1].(*big.Int).Int64()
+	}
+
+	// the pending EVM balance can be delegated
+	pt.StateDB.AddBalance(delegator, big.NewInt(500))
+	_, err := call("delegate", validator, big.NewInt(1200))
+	require.NoError(t, err)
+	require.Equal(t, int64(300), pt.StateDB.GetBalance(delegator).Int64())
+	require.Equal(t, int64(1200), delegation())
+	require.Len(t, pt.StateDB.Logs(), 1)
+	require.Equal(t, staking.ABI.Events["Delegate"].ID, pt.StateDB.Logs()[0].Topics[0])
+
+	// the undelegation is reverted along the EVM state
+	snapshot := pt.StateDB.Snapshot()
+	out, err := call("undelegate", validator, big.NewInt(200))
+	require.NoError(t, err)
+	require.Equal(t, pt.Ctx.BlockTime().Add(pt.App.StakingKeeper.UnbondingTime(pt.Ctx)).Unix(), out[0])
+	require.Equal(t, int64(1000), delegation())
+	pt.StateDB.RevertToSnapshot(snapshot)
+	require.Equal(t, int64(1200), delegation())
+	require.Len(t, pt.StateDB.Logs(), 1)
+
+	// invalid calls
+	_, err = call("delegate", validator, big.NewInt(301))
+	require.Error(t, err)
+	_, err = call("delegate", validator, big.NewInt(0))
+	require.Error(t, err)
+	_, err = call("redelegate", validator, validator, big.NewInt(100))
+	require.Error(t, err)
+	input, err := staking.ABI.Pack("delegate", validator, big.NewInt(100))
+	require.NoError(t, err)
+	_, _, err = pt.EVM.StaticCall(vm.AccountRef(delegator), staking.Address, input, testutil.PrecompileTestGas)
+	require.ErrorIs(t, err, vmerrs.ErrWriteProtection)
+	_, leftOverGas, err := pt.EVM.Call(vm.AccountRef(delegator), staking.Address, input, 1000, big.NewInt(0))
+	require.ErrorIs(t, err, vm.ErrOutOfGas)
+	require.Zero(t, leftOverGas)
+	require.Equal(t, int64(300), pt.StateDB.GetBalance(delegator).Int64())
+
+	require.NoError(t, pt.StateDB.Commit())
+	require.Equal(t, int64(300), pt.App.BankKeeper.GetBalance(pt.Ctx, delegator.Bytes(), sdk.DefaultBondDenom).Amount.Int64())
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	require.NoError(t, err)
+	_, found := pt.App.StakingKeeper.GetDelegation(pt.Ctx, delegator.Bytes(), valAddr)
+	require.True(t, found)
+
+	// no delegation
+	pt.Reset()
+	out, err = pt.Call(delegator, staking.Address, staking.ABI, "delegation", common.Address{}, validator)
+	require.NoError(t, err)
+	require.Zero(t, out[1].(*big.Int).Sign())
+}
@@ -37,6 +37,14 @@ func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNu
 		return nil, err
 	}
 
+	if blockNum == rpctypes.EthPendingBlockNumber {
+		res, err := b.pendingAccountState(address, nil)
+		if err != nil {
+			return nil, err
+		}
+		return res.Code, nil
+	}
+
 	req := &evmtypes.QueryCodeRequest{
 		Address: address.String(),
 	}
@@ -137,6 +145,17 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 		return nil, err
 	}
 
+	if blockNum == rpctypes.EthPendingBlockNumber {
+		res, err := b.pendingAccountState(address, []string{key})
+		if err != nil {
+			return nil, err
+		}
+		if len(res.Storage) != 1 {
+			return nil, fmt.Errorf("invalid pending account state, expected 1 storage value, got %d", len(res.Storage))
+		}
+		return common.HexToHash(res.Storage[0]).Bytes(), nil
+	}
+
 	req := &evmtypes.QueryStorageRequest{
 		Address: address.String(),
 		Key:     key,
@@ -158,22 +177,32 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 		return nil, err
 	}
 
-	req := &evmtypes.QueryBalanceRequest{
-		Address: address.String(),
-	}
+	var balance string
+	if blockNum == rpctypes.EthPendingBlockNumber {
+		res, err := b.pendingAccountState(address, nil)
+		if err != nil {
+			return nil, err
+		}
+		balance = res.Balance
+	} else {
+		req := &evmtypes.QueryBalanceRequest{
+			Address: address.String(),
+		}
 
-	_, err = b.TendermintBlockByNumber(blockNum)
-	if err != nil {
-		return nil, err
-	}
+		_, err = b.TendermintBlockByNumber(blockNum)
+		if err != nil {
+			return nil, err
+		}
 
-	res, err := b.queryClient.Balance(rpctypes.ContextWithHeight(blockNum.Int64()), req)
-	// Responds with error if state is pruned
-	if err != nil {
-		return nil, err
+		res, err := b.queryClient.Balance(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+		// Responds with error if state is pruned
+		if err != nil {
+			return nil, err
+		}
+		balance = res.Balance
 	}
 
-	val, ok := sdkmath.NewIntFromString(res.Balance)
+	val, ok := sdkmath.NewIntFromString(balance)
 	if !ok {
 		return nil, errors.New("invalid balance")
 	}
@@ -208,3 +237,27 @@ func (b *Backend) GetTransactionCount(address common.Address, blockNum rpctypes.
 	n := hexutil.Uint64(nonce)
 	return &n, nil
 }
+
+// pendingAccountState returns the state of the account and the requested storage keys
+// once the ethereum transactions of the mempool are applied on top of the latest state.
+func (b *Backend) pendingAccountState(address common.Address, storageKeys []string) (*evmtypes.QueryPendingAccountStateResponse, error) {
+	header, err := b.TendermintBlockByNumber(rpctypes.EthLatestBlockNumber)
+	if err != nil || header == nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	predecessors, err := b.pendingEthMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.QueryPendingAccountStateRequest{
+		Address:         address.String(),
+		StorageKeys:     storageKeys,
+		Predecessors:    predecessors,
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+	return b.queryClient.PendingAccountState(rpctypes.ContextWithHeight(header.Block.Height), req)
+}
@@ -9,6 +9,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/evmos/ethermint/rpc/backend/mocks"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
@@ -182,6 +183,7 @@ func (suite *BackendTestSuite) TestGetProof() {
 
 func (suite *BackendTestSuite) TestGetStorageAt() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	pendingNr := rpctypes.EthPendingBlockNumber
 
 	testCases := []struct {
 		name          string
@@ -225,6 +227,23 @@ func (suite *BackendTestSuite) TestGetStorageAt() {
 			true,
 			hexutil.Bytes{0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
 		},
+		{
+			"fail - pending state without the storage value",
+			tests.GenerateAddress(),
+			"0x0",
+			rpctypes.BlockNumberOrHash{BlockNumber: &pendingNr},
+			func(addr common.Address, key string, storage string) {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+				RegisterBlock(client, 1, nil)
+				RegisterUnconfirmedTxs(client, nil, nil)
+				RegisterPendingAccountState(queryClient, addr, 1, "2")
+			},
+			false,
+			nil,
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -244,6 +263,7 @@ func (suite *BackendTestSuite) TestGetStorageAt() {
 
 func (suite *BackendTestSuite) TestGetBalance() {
 	blockNr := rpctypes.NewBlockNumber(big.NewInt(1))
+	pendingNr := rpctypes.EthPendingBlockNumber
 
 	testCases := []struct {
 		name          string
@@ -325,6 +345,22 @@ func (suite *BackendTestSuite) TestGetBalance() {
 			true,
 			(*hexutil.Big)(big.NewInt(1)),
 		},
+		{
+			"pass - pending balance",
+			tests.GenerateAddress(),
+			rpctypes.BlockNumberOrHash{BlockNumber: &pendingNr},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				var header metadata.MD
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterParams(queryClient, &header, 1)
+				RegisterBlock(client, 1, nil)
+				RegisterUnconfirmedTxs(client, nil, nil)
+				RegisterPendingAccountState(queryClient, addr, 1, "2")
+			},
+			true,
+			(*hexutil.Big)(big.NewInt(2)),
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
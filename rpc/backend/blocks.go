@@ -64,6 +64,12 @@ func (b *Backend) BlockNumber() (hexutil.Uint64, error) {
 // block number. Depending on fullTx it either returns the full transaction
 // objects or if false only the hashes of the transactions.
 func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	if blockNum > 0 {
+		if res, ok := b.cache.get(cacheKindBlock, blockCacheKey(blockNum.Int64(), fullTx)); ok {
+			return res.(map[string]interface{}), nil
+		}
+	}
+
 	resBlock, err := b.TendermintBlockByNumber(blockNum)
 	if err != nil {
 		return nil, nil
@@ -86,11 +92,18 @@ func (b *Backend) GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (
 		return nil, err
 	}
 
+	height := resBlock.Block.Height
+	b.cacheResponse(cacheKindBlock, blockCacheKey(height, fullTx), height, res)
 	return res, nil
 }
 
+// blockCacheKey returns the key of the cached block at the given height.
+func blockCacheKey(height int64, fullTx bool) string {
+	return fmt.Sprintf("%d/%t", height, fullTx)
+}
+
 // GetBlockByHash returns the JSON-RPC compatible Ethereum block identified by
-// hash.
+// hash. The block is cached like the one of the same height by number.
 func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
 	resBlock, err := b.TendermintBlockByHash(hash)
 	if err != nil {
@@ -102,6 +115,11 @@ func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]inte
 		return nil, nil
 	}
 
+	height := resBlock.Block.Height
+	if res, ok := b.cache.get(cacheKindBlock, blockCacheKey(height, fullTx)); ok {
+		return res.(map[string]interface{}), nil
+	}
+
 	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
 	if err != nil {
 		b.logger.Debug("failed to fetch block result from Tendermint", "block-hash", hash.String(), "error", err.Error())
@@ -114,6 +132,7 @@ func (b *Backend) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]inte
 		return nil, err
 	}
 
+	b.cacheResponse(cacheKindBlock, blockCacheKey(height, fullTx), height, res)
 	return res, nil
 }
 
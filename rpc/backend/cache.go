@@ -0,0 +1,112 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/metrics"
+	lru "github.com/hashicorp/golang-lru"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// kinds of the cached responses, the hits and misses are reported per kind
+const (
+	cacheKindBlock   = "block"
+	cacheKindReceipt = "receipt"
+	cacheKindTrace   = "trace"
+	cacheKindLogs    = "logs"
+)
+
+// cacheKey identifies a cached response by its kind and the key of the
+// queried data, eg. its height or hash.
+type cacheKey struct {
+	kind string
+	key  string
+}
+
+// responseCache is a LRU cache of the responses of the queries of historical
+// data, which can't change once it's below the latest committed height.
+type responseCache struct {
+	lru *lru.Cache
+}
+
+// newResponseCache creates a cache of the given max number of responses, it
+// returns nil if the size is 0 which disables the cache.
+func newResponseCache(size int) *responseCache {
+	if size <= 0 {
+		return nil
+	}
+
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &responseCache{lru: cache}
+}
+
+// get returns the cached response of the given kind and key, it's nil safe.
+func (c *responseCache) get(kind, key string) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	value, ok := c.lru.Get(cacheKey{kind: kind, key: key})
+	if ok {
+		metrics.GetOrRegisterCounter("rpc/cache/"+kind+"/hits", nil).Inc(1)
+	} else {
+		metrics.GetOrRegisterCounter("rpc/cache/"+kind+"/misses", nil).Inc(1)
+	}
+	return value, ok
+}
+
+// add caches the response of the given kind and key, it's nil safe.
+func (c *responseCache) add(kind, key string, value interface{}) {
+	if c == nil {
+		return
+	}
+	c.lru.Add(cacheKey{kind: kind, key: key}, value)
+}
+
+// cacheResponse caches the response of the data at the given height if it's
+// strictly below the latest committed height, ie. it can't change anymore.
+func (b *Backend) cacheResponse(kind, key string, height int64, value interface{}) {
+	if b.cache == nil {
+		return
+	}
+
+	latest, err := b.BlockNumber()
+	if err != nil || height >= int64(latest) {
+		return
+	}
+	b.cache.add(kind, key, value)
+}
+
+// traceCacheKey returns the key of the trace of the transaction with the given
+// config, the trace depends on the tracer and its options.
+func traceCacheKey(hash common.Hash, config *evmtypes.TraceConfig) (string, bool) {
+	if config == nil {
+		return hash.Hex(), true
+	}
+
+	bz, err := json.Marshal(config)
+	if err != nil {
+		return "", false
+	}
+	return hash.Hex() + "/" + string(bz), true
+}
@@ -0,0 +1,130 @@
+package backend
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	mock "github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// registerLatestHeight mocks the latest committed height returned by BlockNumber.
+func registerLatestHeight(queryClient *mocks.EVMQueryClient, height int64) {
+	queryClient.On("Params", mock.Anything, &evmtypes.QueryParamsRequest{}, mock.Anything).
+		Return(&evmtypes.QueryParamsResponse{}, nil).
+		Maybe().
+		Run(func(args mock.Arguments) {
+			arg := args.Get(2).(grpc.HeaderCallOption)
+			h := metadata.MD{}
+			h.Set(grpctypes.GRPCBlockHeightHeader, fmt.Sprint(height))
+			*arg.HeaderAddr = h
+		})
+}
+
+func (suite *BackendTestSuite) TestResponseCache() {
+	cache := newResponseCache(2)
+	cache.add(cacheKindBlock, "1", 1)
+	cache.add(cacheKindLogs, "1", 2)
+
+	value, ok := cache.get(cacheKindBlock, "1")
+	suite.Require().True(ok)
+	suite.Require().Equal(1, value)
+
+	// the least recently used response is evicted
+	cache.add(cacheKindBlock, "2", 3)
+	_, ok = cache.get(cacheKindLogs, "1")
+	suite.Require().False(ok)
+	_, ok = cache.get(cacheKindBlock, "1")
+	suite.Require().True(ok)
+
+	// a disabled cache never hits
+	suite.Require().Nil(newResponseCache(0))
+	var disabled *responseCache
+	disabled.add(cacheKindBlock, "1", 1)
+	_, ok = disabled.get(cacheKindBlock, "1")
+	suite.Require().False(ok)
+}
+
+func (suite *BackendTestSuite) TestGetLogsByHeightCached() {
+	testCases := []struct {
+		name      string
+		height    int64
+		latest    int64
+		cacheSize int
+		expCached bool
+	}{
+		{"pass - below the latest height", 1, 2, 10, true},
+		{"pass - latest height isn't cached", 1, 1, 10, false},
+		{"pass - cache disabled", 1, 2, 0, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.backend.cache = newResponseCache(tc.cacheSize)
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			RegisterBlockResults(client, tc.height)
+			registerLatestHeight(queryClient, tc.latest)
+
+			logs, err := suite.backend.GetLogsByHeight(&tc.height)
+			suite.Require().NoError(err)
+			suite.Require().Equal([][]*ethtypes.Log{}, logs)
+
+			_, cached := suite.backend.cache.get(cacheKindLogs, fmt.Sprint(tc.height))
+			suite.Require().Equal(tc.expCached, cached)
+			if !cached {
+				return
+			}
+
+			// served without querying the node
+			suite.backend.clientCtx.Client = mocks.NewClient(suite.T())
+			cachedLogs, err := suite.backend.GetLogsByHeight(&tc.height)
+			suite.Require().NoError(err)
+			suite.Require().Equal(logs, cachedLogs)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetBlockByHashCached() {
+	suite.backend.cache = newResponseCache(10)
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	hash := common.Hash{}
+	_, err := RegisterBlockByHash(client, hash, nil)
+	suite.Require().NoError(err)
+	_, err = RegisterBlockResults(client, 1)
+	suite.Require().NoError(err)
+	RegisterConsensusParams(client, 1)
+	RegisterBaseFee(queryClient, sdk.NewInt(1))
+	RegisterValidatorAccount(queryClient, sdk.AccAddress(tests.GenerateAddress().Bytes()))
+	registerLatestHeight(queryClient, 2)
+
+	// the block is cached by height once the hash is resolved
+	block, err := suite.backend.GetBlockByHash(hash, true)
+	suite.Require().NoError(err)
+	cached, ok := suite.backend.cache.get(cacheKindBlock, blockCacheKey(1, true))
+	suite.Require().True(ok)
+	suite.Require().Equal(block, cached)
+
+	// the cached block is served by number and by hash without the results
+	suite.backend.clientCtx.Client = mocks.NewClient(suite.T())
+	res, err := suite.backend.GetBlockByNumber(1, true)
+	suite.Require().NoError(err)
+	suite.Require().Equal(block, res)
+
+	client = mocks.NewClient(suite.T())
+	suite.backend.clientCtx.Client = client
+	_, err = RegisterBlockByHash(client, hash, nil)
+	suite.Require().NoError(err)
+	res, err = suite.backend.GetBlockByHash(hash, true)
+	suite.Require().NoError(err)
+	suite.Require().Equal(block, res)
+}
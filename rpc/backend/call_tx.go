@@ -289,7 +289,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		}
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil, nil)
 		if err != nil {
 			return args, err
 		}
@@ -305,8 +305,15 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
-	blockNr := rpctypes.EthPendingBlockNumber
+// The state and block overrides are optional and applied before the estimation.
+func (b *Backend) EstimateGas(
+	args evmtypes.TransactionArgs,
+	blockNrOptional *rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
+) (hexutil.Uint64, error) {
+	// the pending state replays the whole mempool, so it's only used on request
+	blockNr := rpctypes.EthLatestBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
 	}
@@ -328,6 +335,15 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 	}
+	req.Overrides, req.BlockOverrides, err = encodeCallOverrides(overrides, blockOverrides)
+	if err != nil {
+		return 0, err
+	}
+	if blockNr == rpctypes.EthPendingBlockNumber {
+		if req.Predecessors, err = b.pendingEthMsgs(); err != nil {
+			return 0, err
+		}
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -341,8 +357,12 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 
 // DoCall performs a simulated call operation through the evmtypes. It returns the
 // estimated gas used on the operation or an error if fails.
+// The state and block overrides are optional and applied before the call.
 func (b *Backend) DoCall(
-	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
@@ -360,6 +380,15 @@ func (b *Backend) DoCall(
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 	}
+	req.Overrides, req.BlockOverrides, err = encodeCallOverrides(overrides, blockOverrides)
+	if err != nil {
+		return nil, err
+	}
+	if blockNr == rpctypes.EthPendingBlockNumber {
+		if req.Predecessors, err = b.pendingEthMsgs(); err != nil {
+			return nil, err
+		}
+	}
 
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
@@ -395,6 +424,71 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// CreateAccessList creates an EIP-2930 access list for the given transaction
+// on top of the state of the requested block, it returns the access list along
+// with the gas used by the transaction once the list is applied.
+func (b *Backend) CreateAccessList(
+	args evmtypes.TransactionArgs,
+	blockNr rpctypes.BlockNumber,
+) (*rpctypes.AccessListResult, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+	if blockNr == rpctypes.EthPendingBlockNumber {
+		if req.Predecessors, err = b.pendingEthMsgs(); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := b.queryClient.CreateAccessList(rpctypes.ContextWithHeight(blockNr.Int64()), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	accessList := res.AccessList.ToEthAccessList()
+	if *accessList == nil {
+		// return an empty list instead of null, the same as geth
+		*accessList = ethtypes.AccessList{}
+	}
+
+	return &rpctypes.AccessListResult{
+		AccessList: accessList,
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
+}
+
+// encodeCallOverrides json-encodes the optional state and block overrides of a call.
+func encodeCallOverrides(
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
+) (overridesBz []byte, blockOverridesBz []byte, err error) {
+	if overrides != nil {
+		if overridesBz, err = json.Marshal(overrides); err != nil {
+			return nil, nil, err
+		}
+	}
+	if blockOverrides != nil {
+		if blockOverridesBz, err = json.Marshal(blockOverrides); err != nil {
+			return nil, nil, err
+		}
+	}
+	return overridesBz, blockOverridesBz, nil
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
@@ -50,6 +50,28 @@ func RegisterTraceTransactionError(queryClient *mocks.EVMQueryClient, msgEthTx *
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// CreateAccessList
+func RegisterCreateAccessList(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest, accessList evmtypes.AccessList) {
+	queryClient.On("CreateAccessList", rpc.ContextWithHeight(1), request).
+		Return(&evmtypes.QueryCreateAccessListResponse{AccessList: accessList, GasUsed: 21000}, nil)
+}
+
+func RegisterCreateAccessListError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	queryClient.On("CreateAccessList", rpc.ContextWithHeight(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// TraceCall
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(&evmtypes.QueryTraceCallResponse{Data: data}, nil)
+}
+
+func RegisterTraceCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	queryClient.On("TraceCall", rpc.ContextWithHeight(1), request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // TraceBlock
 func RegisterTraceBlock(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEthereumTx) {
 	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
@@ -260,6 +282,14 @@ func RegisterBalance(queryClient *mocks.EVMQueryClient, addr common.Address, hei
 		Return(&evmtypes.QueryBalanceResponse{Balance: "1"}, nil)
 }
 
+// PendingAccountState
+func RegisterPendingAccountState(queryClient *mocks.EVMQueryClient, addr common.Address, height int64, balance string) {
+	queryClient.On("PendingAccountState", rpc.ContextWithHeight(height), mock.MatchedBy(func(req *evmtypes.QueryPendingAccountStateRequest) bool {
+		return req.Address == addr.String()
+	})).
+		Return(&evmtypes.QueryPendingAccountStateResponse{Balance: balance}, nil)
+}
+
 func RegisterBalanceInvalid(queryClient *mocks.EVMQueryClient, addr common.Address, height int64) {
 	queryClient.On("Balance", rpc.ContextWithHeight(height), &evmtypes.QueryBalanceRequest{Address: addr.String()}).
 		Return(&evmtypes.QueryBalanceResponse{Balance: "invalid"}, nil)
@@ -16,9 +16,13 @@
 package backend
 
 import (
+	"strconv"
+
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+
+	ethermint "github.com/evmos/ethermint/types"
 )
 
 // GetLogs returns all the logs from all the ethereum transactions in a block.
@@ -35,13 +39,25 @@ func (b *Backend) GetLogs(hash common.Hash) ([][]*ethtypes.Log, error) {
 
 // GetLogsByHeight returns all the logs from all the ethereum transactions in a block.
 func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	if height != nil {
+		if logs, ok := b.cache.get(cacheKindLogs, strconv.FormatInt(*height, 10)); ok {
+			return logs.([][]*ethtypes.Log), nil
+		}
+	}
+
 	// NOTE: we query the state in case the tx result logs are not persisted after an upgrade.
 	blockRes, err := b.TendermintBlockResultByNumber(height)
 	if err != nil {
 		return nil, err
 	}
 
-	return GetLogsFromBlockResults(blockRes)
+	logs, err := GetLogsFromBlockResults(blockRes)
+	if err != nil {
+		return nil, err
+	}
+
+	b.cacheResponse(cacheKindLogs, strconv.FormatInt(blockRes.Height, 10), blockRes.Height, logs)
+	return logs, nil
 }
 
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
@@ -49,3 +65,13 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 func (b *Backend) BloomStatus() (uint64, uint64) {
 	return 4096, 0
 }
+
+// LogIndexer returns the custom eth tx indexer if it also indexes the log addresses and
+// topics, returns nil otherwise.
+func (b *Backend) LogIndexer() ethermint.EVMLogIndexer {
+	logIndexer, ok := b.indexer.(ethermint.EVMLogIndexer)
+	if !ok {
+		return nil
+	}
+	return logIndexer
+}
@@ -335,6 +335,11 @@ func (b *Backend) RPCBlockRangeCap() int32 {
 	return b.cfg.JSONRPC.BlockRangeCap
 }
 
+// RPCTraceFilterBlockRangeCap defines the max block range allowed for `trace_filter` query.
+func (b *Backend) RPCTraceFilterBlockRangeCap() int32 {
+	return b.cfg.JSONRPC.TraceFilterBlockRangeCap
+}
+
 // RPCMinGasPrice returns the minimum gas price for a transaction obtained from
 // the node config. If set value is 0, it will default to 20.
 
@@ -0,0 +1,302 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+const (
+	// callTracer is the tracer the Parity traces are built from
+	callTracer = "callTracer"
+
+	// parityTraceTypeTrace is the only trace type supported by the `trace_call`
+	// and `trace_replayBlockTransactions` APIs.
+	parityTraceTypeTrace = "trace"
+)
+
+// ParityTraceTransaction returns the Parity traces of the call tree of the
+// transaction.
+func (b *Backend) ParityTraceTransaction(hash common.Hash) ([]*rpctypes.ParityTrace, error) {
+	transaction, err := b.GetTxByEthHash(hash)
+	if err != nil {
+		b.logger.Debug("tx not found", "hash", hash)
+		return nil, err
+	}
+
+	res, err := b.TraceTransaction(hash, &evmtypes.TraceConfig{Tracer: callTracer})
+	if err != nil {
+		return nil, err
+	}
+
+	frame, err := decodeCallFrame(res)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(transaction.Height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil {
+		return nil, errors.Errorf("block not found for height %d", transaction.Height)
+	}
+
+	traces := rpctypes.ParityTracesFromCallFrame(frame)
+	setParityTraceContext(
+		traces,
+		common.BytesToHash(resBlock.Block.Header.Hash()),
+		uint64(transaction.Height),
+		hash,
+		uint64(transaction.EthTxIndex),
+	)
+	return traces, nil
+}
+
+// ParityTraceBlock returns the Parity traces of all the ethereum transactions
+// of the block.
+func (b *Backend) ParityTraceBlock(blockNum rpctypes.BlockNumber) ([]*rpctypes.ParityTrace, error) {
+	results, err := b.parityTraceBlock(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := []*rpctypes.ParityTrace{}
+	for _, res := range results {
+		traces = append(traces, res.Trace...)
+	}
+	return traces, nil
+}
+
+// ParityTraceReplayBlockTransactions replays all the ethereum transactions of
+// the block and returns the requested trace types of each of them.
+func (b *Backend) ParityTraceReplayBlockTransactions(blockNum rpctypes.BlockNumber, traceTypes []string) ([]*rpctypes.ParityTraceResults, error) {
+	withTrace, err := parseParityTraceTypes(traceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := b.parityTraceBlock(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	if !withTrace {
+		for _, res := range results {
+			res.Trace = []*rpctypes.ParityTrace{}
+		}
+	}
+	return results, nil
+}
+
+// ParityTraceCall executes the given call on top of the state of the requested
+// block without committing it and returns the requested trace types.
+func (b *Backend) ParityTraceCall(
+	args evmtypes.TransactionArgs,
+	traceTypes []string,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+) (*rpctypes.ParityTraceResults, error) {
+	withTrace, err := parseParityTraceTypes(traceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &rpctypes.TraceCallConfig{TraceConfig: evmtypes.TraceConfig{Tracer: callTracer}}
+	res, err := b.TraceCall(args, blockNrOrHash, config)
+	if err != nil {
+		return nil, err
+	}
+
+	frame, err := decodeCallFrame(res)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rpctypes.ParityTraceResults{
+		Output: frame.Output,
+		Trace:  []*rpctypes.ParityTrace{},
+	}
+	if withTrace {
+		result.Trace = rpctypes.ParityTracesFromCallFrame(frame)
+	}
+	return result, nil
+}
+
+// ParityTraceFilter returns the Parity traces of the block range matching the
+// from and to addresses of the filter. Every block of the range is re-executed,
+// so the range is bounded by the trace filter block range cap of the JSON-RPC
+// server, much smaller than the one of `eth_getLogs`.
+func (b *Backend) ParityTraceFilter(args rpctypes.TraceFilterArgs) ([]*rpctypes.ParityTrace, error) {
+	head, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	from := resolveTraceFilterBlock(args.FromBlock, int64(head))
+	to := resolveTraceFilterBlock(args.ToBlock, int64(head))
+	if from > to {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", from, to)
+	}
+	if blockLimit := int64(b.RPCTraceFilterBlockRangeCap()); to-from > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+	if to > int64(head) {
+		to = int64(head)
+	}
+
+	var skipped uint64
+	traces := []*rpctypes.ParityTrace{}
+	for height := from; height <= to; height++ {
+		blockTraces, err := b.ParityTraceBlock(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range blockTraces {
+			if args.Count != nil && uint64(len(traces)) >= *args.Count {
+				return traces, nil
+			}
+			if !args.Matches(trace) {
+				continue
+			}
+			if args.After != nil && skipped < *args.After {
+				skipped++
+				continue
+			}
+			traces = append(traces, trace)
+		}
+	}
+	return traces, nil
+}
+
+// parityTraceBlock traces the ethereum transactions of the block with the
+// `callTracer` and converts the call trees into Parity traces.
+func (b *Backend) parityTraceBlock(blockNum rpctypes.BlockNumber) ([]*rpctypes.ParityTraceResults, error) {
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil {
+		return nil, errors.Errorf("block not found for height %d", blockNum)
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		b.logger.Debug("block result not found", "height", resBlock.Block.Height, "error", err.Error())
+		return nil, err
+	}
+
+	results := []*rpctypes.ParityTraceResults{}
+	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	if len(msgs) == 0 {
+		return results, nil
+	}
+
+	height := resBlock.Block.Height
+	res, err := b.traceBlockMsgs(rpctypes.BlockNumber(height), &evmtypes.TraceConfig{Tracer: callTracer}, resBlock, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	var txResults []struct {
+		Result *rpctypes.CallFrame `json:"result"`
+		Error  string              `json:"error"`
+	}
+	if err := json.Unmarshal(res.Data, &txResults); err != nil {
+		return nil, err
+	}
+	if len(txResults) != len(msgs) {
+		return nil, fmt.Errorf("invalid number of trace results, expected %d, got %d", len(msgs), len(txResults))
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+	for i, txResult := range txResults {
+		txHash := common.HexToHash(msgs[i].Hash)
+		if txResult.Error != "" || txResult.Result == nil {
+			return nil, fmt.Errorf("failed to trace transaction %s: %s", txHash.Hex(), txResult.Error)
+		}
+
+		traces := rpctypes.ParityTracesFromCallFrame(txResult.Result)
+		setParityTraceContext(traces, blockHash, uint64(height), txHash, uint64(i))
+		results = append(results, &rpctypes.ParityTraceResults{
+			Output:          txResult.Result.Output,
+			Trace:           traces,
+			TransactionHash: &txHash,
+		})
+	}
+	return results, nil
+}
+
+// decodeCallFrame decodes the result of the `callTracer`.
+func decodeCallFrame(res interface{}) (*rpctypes.CallFrame, error) {
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	var frame rpctypes.CallFrame
+	if err := json.Unmarshal(bz, &frame); err != nil {
+		return nil, errors.Wrap(err, "failed to decode call trace")
+	}
+	return &frame, nil
+}
+
+// setParityTraceContext sets the block and transaction of the traces.
+func setParityTraceContext(traces []*rpctypes.ParityTrace, blockHash common.Hash, blockNumber uint64, txHash common.Hash, txIndex uint64) {
+	for _, trace := range traces {
+		trace.BlockHash = &blockHash
+		trace.BlockNumber = &blockNumber
+		trace.TransactionHash = &txHash
+		trace.TransactionPosition = &txIndex
+	}
+}
+
+// parseParityTraceTypes validates the requested trace types and returns true
+// if the `trace` type is requested.
+func parseParityTraceTypes(traceTypes []string) (bool, error) {
+	withTrace := false
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case parityTraceTypeTrace:
+			withTrace = true
+		case "stateDiff", "vmTrace":
+			return false, fmt.Errorf("trace type %s is not supported", traceType)
+		default:
+			return false, fmt.Errorf("invalid trace type %s", traceType)
+		}
+	}
+	return withTrace, nil
+}
+
+// resolveTraceFilterBlock returns the height of a `trace_filter` block bound,
+// which defaults to the latest block. The genesis block is not traceable, the
+// range starts at block 1 at the earliest.
+func resolveTraceFilterBlock(blockNum *rpctypes.BlockNumber, head int64) int64 {
+	if blockNum == nil || *blockNum < 0 {
+		return head
+	}
+	if *blockNum == rpctypes.EthEarliestBlockNumber {
+		return 1
+	}
+	return blockNum.Int64()
+}
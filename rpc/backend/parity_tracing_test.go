@@ -0,0 +1,337 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var callTracerData = []byte(`{
+	"type": "CALL",
+	"from": "0x0000000000000000000000000000000000000001",
+	"to": "0x0000000000000000000000000000000000000002",
+	"gas": "0x186a0",
+	"gasUsed": "0x5208",
+	"input": "0x",
+	"value": "0x0",
+	"calls": [{
+		"type": "DELEGATECALL",
+		"from": "0x0000000000000000000000000000000000000002",
+		"to": "0x0000000000000000000000000000000000000003",
+		"gas": "0x100",
+		"gasUsed": "0x10",
+		"input": "0x",
+		"error": "execution reverted"
+	}]
+}`)
+
+// registerParityTraceBlock registers the block 1 containing the given tx and
+// its call trace, and returns the expected Parity traces.
+func (suite *BackendTestSuite) registerParityTraceBlock(bz []byte) []*rpctypes.ParityTrace {
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	resBlock, _ := RegisterBlock(client, 1, bz)
+	blockRes, _ := RegisterBlockResults(client, 1)
+
+	msgs := suite.backend.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	queryClient.On("TraceBlock", rpctypes.ContextWithHeight(1), &evmtypes.QueryTraceBlockRequest{
+		Txs:             msgs,
+		TraceConfig:     &evmtypes.TraceConfig{Tracer: "callTracer"},
+		BlockNumber:     1,
+		BlockTime:       resBlock.Block.Time,
+		BlockHash:       common.Bytes2Hex(resBlock.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(resBlock.Block.ProposerAddress),
+		ChainId:         suite.backend.chainID.Int64(),
+	}).Return(&evmtypes.QueryTraceBlockResponse{Data: []byte(fmt.Sprintf(`[{"result":%s}]`, callTracerData))}, nil)
+
+	return suite.expParityTraces(resBlock, common.HexToHash(msgs[0].Hash))
+}
+
+func (suite *BackendTestSuite) expParityTraces(resBlock *tmrpctypes.ResultBlock, txHash common.Hash) []*rpctypes.ParityTrace {
+	var frame rpctypes.CallFrame
+	suite.Require().NoError(json.Unmarshal(callTracerData, &frame))
+	traces := rpctypes.ParityTracesFromCallFrame(&frame)
+	if resBlock != nil {
+		setParityTraceContext(traces, common.BytesToHash(resBlock.Block.Header.Hash()), 1, txHash, 0)
+	}
+	return traces
+}
+
+func (suite *BackendTestSuite) TestParityTraceBlock() {
+	_, bz := suite.buildEthereumTx()
+
+	testCases := []struct {
+		name         string
+		registerMock func() []*rpctypes.ParityTrace
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() []*rpctypes.ParityTrace {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+				return nil
+			},
+			false,
+		},
+		{
+			"pass - block without transactions",
+			func() []*rpctypes.ParityTrace {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, nil)
+				RegisterBlockResults(client, 1)
+				return []*rpctypes.ParityTrace{}
+			},
+			true,
+		},
+		{
+			"pass - block with transaction",
+			func() []*rpctypes.ParityTrace {
+				return suite.registerParityTraceBlock(bz)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			expTraces := tc.registerMock()
+
+			traces, err := suite.backend.ParityTraceBlock(1)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expTraces, traces)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestParityTraceReplayBlockTransactions() {
+	_, bz := suite.buildEthereumTx()
+
+	testCases := []struct {
+		name       string
+		traceTypes []string
+		expTrace   bool
+		expPass    bool
+	}{
+		{"fail - unsupported trace type", []string{"trace", "vmTrace"}, false, false},
+		{"fail - invalid trace type", []string{"invalid"}, false, false},
+		{"pass - without trace", []string{}, false, true},
+		{"pass - with trace", []string{"trace"}, true, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			var expTraces []*rpctypes.ParityTrace
+			if tc.expPass {
+				expTraces = suite.registerParityTraceBlock(bz)
+			}
+
+			results, err := suite.backend.ParityTraceReplayBlockTransactions(1, tc.traceTypes)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(results, 1)
+				suite.Require().Equal(*expTraces[0].TransactionHash, *results[0].TransactionHash)
+				suite.Require().Nil(results[0].StateDiff)
+				suite.Require().Nil(results[0].VMTrace)
+				if tc.expTrace {
+					suite.Require().Equal(expTraces, results[0].Trace)
+				} else {
+					suite.Require().Empty(results[0].Trace)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestParityTraceCall() {
+	_, bz := suite.buildEthereumTx()
+	blockNum := rpctypes.BlockNumber(1)
+	blockNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}
+	to := tests.GenerateAddress()
+	args := evmtypes.TransactionArgs{To: &to}
+	argsBz, err := json.Marshal(&args)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		traceTypes   []string
+		expTrace     bool
+		expPass      bool
+	}{
+		{
+			"fail - unsupported trace type",
+			func() {},
+			[]string{"stateDiff"},
+			false,
+			false,
+		},
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			[]string{"trace"},
+			false,
+			false,
+		},
+		{
+			"pass - trace call",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				resBlock, _ := RegisterBlock(client, 1, bz)
+				queryClient.On("TraceCall", rpctypes.ContextWithHeight(1), &evmtypes.QueryTraceCallRequest{
+					Args:            argsBz,
+					GasCap:          suite.backend.RPCGasCap(),
+					ProposerAddress: sdk.ConsAddress(resBlock.Block.ProposerAddress),
+					ChainId:         suite.backend.chainID.Int64(),
+					TraceConfig:     &evmtypes.TraceConfig{Tracer: "callTracer"},
+				}).Return(&evmtypes.QueryTraceCallResponse{Data: callTracerData}, nil)
+			},
+			[]string{"trace"},
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.ParityTraceCall(args, tc.traceTypes, blockNrOrHash)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(hexutil.Bytes(nil), result.Output)
+				suite.Require().Nil(result.TransactionHash)
+				if tc.expTrace {
+					suite.Require().Equal(suite.expParityTraces(nil, common.Hash{}), result.Trace)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestParityTraceFilter() {
+	_, bz := suite.buildEthereumTx()
+	from := rpctypes.BlockNumber(1)
+	two := rpctypes.BlockNumber(2)
+	three := rpctypes.BlockNumber(3)
+	count := uint64(1)
+	after := uint64(1)
+
+	testCases := []struct {
+		name          string
+		args          rpctypes.TraceFilterArgs
+		registerTrace bool
+		expTraces     func([]*rpctypes.ParityTrace) []*rpctypes.ParityTrace
+		expPass       bool
+	}{
+		{
+			"fail - invalid block range",
+			rpctypes.TraceFilterArgs{FromBlock: &two, ToBlock: &from},
+			false,
+			nil,
+			false,
+		},
+		{
+			"fail - block range cap exceeded",
+			rpctypes.TraceFilterArgs{FromBlock: &from, ToBlock: &three},
+			false,
+			nil,
+			false,
+		},
+		{
+			"pass - range past the latest block",
+			rpctypes.TraceFilterArgs{FromBlock: &two, ToBlock: &two},
+			false,
+			func([]*rpctypes.ParityTrace) []*rpctypes.ParityTrace { return []*rpctypes.ParityTrace{} },
+			true,
+		},
+		{
+			"pass - all traces of the latest block",
+			rpctypes.TraceFilterArgs{},
+			true,
+			func(traces []*rpctypes.ParityTrace) []*rpctypes.ParityTrace { return traces },
+			true,
+		},
+		{
+			"pass - from address",
+			rpctypes.TraceFilterArgs{FromAddress: []common.Address{common.HexToAddress("0x2")}},
+			true,
+			func(traces []*rpctypes.ParityTrace) []*rpctypes.ParityTrace { return traces[1:] },
+			true,
+		},
+		{
+			"pass - to address",
+			rpctypes.TraceFilterArgs{ToAddress: []common.Address{common.HexToAddress("0x2")}},
+			true,
+			func(traces []*rpctypes.ParityTrace) []*rpctypes.ParityTrace { return traces[:1] },
+			true,
+		},
+		{
+			"pass - count",
+			rpctypes.TraceFilterArgs{FromBlock: &from, Count: &count},
+			true,
+			func(traces []*rpctypes.ParityTrace) []*rpctypes.ParityTrace { return traces[:1] },
+			true,
+		},
+		{
+			"pass - after",
+			rpctypes.TraceFilterArgs{FromBlock: &from, After: &after},
+			true,
+			func(traces []*rpctypes.ParityTrace) []*rpctypes.ParityTrace { return traces[1:] },
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cfg.JSONRPC.TraceFilterBlockRangeCap = 1
+
+			var header metadata.MD
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			RegisterParams(queryClient, &header, 1)
+
+			var traces []*rpctypes.ParityTrace
+			if tc.registerTrace {
+				traces = suite.registerParityTraceBlock(bz)
+			}
+
+			res, err := suite.backend.ParityTraceFilter(tc.args)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expTraces(traces), res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
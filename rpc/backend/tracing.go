@@ -31,6 +31,13 @@ import (
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
 func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
+	cacheKey, cacheable := traceCacheKey(hash, config)
+	if cacheable {
+		if res, ok := b.cache.get(cacheKindTrace, cacheKey); ok {
+			return res, nil
+		}
+	}
+
 	// Get transaction by hash
 	transaction, err := b.GetTxByEthHash(hash)
 	if err != nil {
@@ -130,6 +137,9 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 
 	if !ok {
 		// gracefully fallback to default behavior
+		if cacheable {
+			b.cacheResponse(cacheKindTrace, cacheKey, transaction.Height, decodedResult)
+		}
 		return decodedResult, nil
 	}
 
@@ -170,6 +180,9 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		}
 	}
 
+	if cacheable {
+		b.cacheResponse(cacheKindTrace, cacheKey, transaction.Height, jsonResult)
+	}
 	return jsonResult, nil
 }
 
@@ -208,6 +221,26 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		}
 	}
 
+	res, err := b.traceBlockMsgs(height, config, block, txsMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedResults := make([]*evmtypes.TxTraceResult, txsLength)
+	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
+		return nil, err
+	}
+
+	return decodedResults, nil
+}
+
+// traceBlockMsgs traces the given ethereum messages of the block on top of the
+// state at the beginning of the block.
+func (b *Backend) traceBlockMsgs(height rpctypes.BlockNumber,
+	config *evmtypes.TraceConfig,
+	block *tmrpctypes.ResultBlock,
+	msgs []*evmtypes.MsgEthereumTx,
+) (*evmtypes.QueryTraceBlockResponse, error) {
 	// minus one to get the context at the beginning of the block
 	contextHeight := height - 1
 	if contextHeight < 1 {
@@ -217,7 +250,7 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 	ctxWithHeight := rpctypes.ContextWithHeight(int64(contextHeight))
 
 	traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
-		Txs:             txsMessages,
+		Txs:             msgs,
 		TraceConfig:     config,
 		BlockNumber:     block.Block.Height,
 		BlockTime:       block.Block.Time,
@@ -226,15 +259,58 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		ChainId:         b.chainID.Int64(),
 	}
 
-	res, err := b.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
+	return b.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
+}
+
+// TraceCall executes the given call on top of the state of the requested block
+// without committing it and returns the structured logs created during the
+// execution of EVM, the return value depends on the requested tracer.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	blockNr, err := b.BlockNumberFromTendermint(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
 
-	decodedResults := make([]*evmtypes.TxTraceResult, txsLength)
-	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
 		return nil, err
 	}
 
-	return decodedResults, nil
+	req := evmtypes.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	if config != nil {
+		req.TraceConfig = &config.TraceConfig
+		req.Overrides, req.BlockOverrides, err = encodeCallOverrides(config.StateOverrides, config.BlockOverrides)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := b.queryClient.TraceCall(rpctypes.ContextWithHeight(blockNr.Int64()), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	var decodedResult interface{}
+	if err := json.Unmarshal(res.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
 }
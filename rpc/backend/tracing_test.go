@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 
 	dbm "github.com/cometbft/cometbft-db"
@@ -9,11 +10,15 @@ import (
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	"github.com/evmos/ethermint/indexer"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	"github.com/evmos/ethermint/tests"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -282,3 +287,92 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	_, bz := suite.buildEthereumTx()
+	blockNum := rpctypes.BlockNumber(1)
+	blockNrOrHash := rpctypes.BlockNumberOrHash{BlockNumber: &blockNum}
+	to := tests.GenerateAddress()
+	args := evmtypes.TransactionArgs{To: &to}
+	argsBz, err := json.Marshal(&args)
+	suite.Require().NoError(err)
+
+	code := hexutil.Bytes{0x60, 0x00}
+	config := &rpctypes.TraceCallConfig{
+		TraceConfig:    evmtypes.TraceConfig{DisableStack: true},
+		StateOverrides: &rpctypes.StateOverride{to: rpctypes.OverrideAccount{Code: &code}},
+	}
+	overridesBz, err := json.Marshal(config.StateOverrides)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		config       *rpctypes.TraceCallConfig
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			"fail - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"fail - trace call query error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				resBlock, _ := RegisterBlock(client, 1, bz)
+				RegisterTraceCallError(queryClient, &evmtypes.QueryTraceCallRequest{
+					Args:            argsBz,
+					GasCap:          suite.backend.RPCGasCap(),
+					ProposerAddress: sdk.ConsAddress(resBlock.Block.ProposerAddress),
+					ChainId:         suite.backend.chainID.Int64(),
+				})
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - trace call with state overrides",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				resBlock, _ := RegisterBlock(client, 1, bz)
+				RegisterTraceCall(queryClient, &evmtypes.QueryTraceCallRequest{
+					Args:            argsBz,
+					GasCap:          suite.backend.RPCGasCap(),
+					ProposerAddress: sdk.ConsAddress(resBlock.Block.ProposerAddress),
+					ChainId:         suite.backend.chainID.Int64(),
+					TraceConfig:     &config.TraceConfig,
+					Overrides:       overridesBz,
+				})
+			},
+			config,
+			map[string]interface{}{"failed": false, "test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.TraceCall(args, blockNrOrHash, tc.config)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
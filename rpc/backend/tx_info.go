@@ -17,6 +17,7 @@ package backend
 
 import (
 	"fmt"
+	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
@@ -32,6 +33,10 @@ import (
 	"github.com/pkg/errors"
 )
 
+// MaxTxQueryLimit is the max number of hashes returned by the queries of the
+// eth txs by address.
+const MaxTxQueryLimit = 1000
+
 // GetTransactionByHash returns the Ethereum format transaction identified by Ethereum transaction hash
 func (b *Backend) GetTransactionByHash(txHash common.Hash) (*rpctypes.RPCTransaction, error) {
 	res, err := b.GetTxByEthHash(txHash)
@@ -137,6 +142,10 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	hexTx := hash.Hex()
 	b.logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 
+	if receipt, ok := b.cache.get(cacheKindReceipt, hexTx); ok {
+		return receipt.(map[string]interface{}), nil
+	}
+
 	res, err := b.GetTxByEthHash(hash)
 	if err != nil {
 		b.logger.Debug("tx not found", "hash", hexTx, "error", err.Error())
@@ -155,18 +164,147 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 	ethMsg := tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
 
-	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	if res.EthTxIndex == -1 {
+		// Fallback to find tx index by iterating all valid eth transactions
+		msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
+		for i := range msgs {
+			if msgs[i].Hash == hexTx {
+				res.EthTxIndex = int32(i)
+				break
+			}
+		}
+	}
+	// return error if still unable to find the eth tx index
+	if res.EthTxIndex == -1 {
+		return nil, errors.New("can't find index of ethereum tx")
+	}
+
+	chainID, err := b.ChainID()
 	if err != nil {
-		b.logger.Error("failed to unpack tx data", "error", err.Error())
 		return nil, err
 	}
 
-	cumulativeGasUsed := uint64(0)
-	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
+	blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+	receipt, err := b.formatTxReceipt(ethMsg, res, blockRes, blockHash, chainID.ToInt())
 	if err != nil {
-		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, err
+	}
+
+	b.cacheResponse(cacheKindReceipt, hexTx, res.Height, receipt)
+	return receipt, nil
+}
+
+// GetBlockReceipts returns the receipts of all the ethereum transactions in the given block,
+// the block results are fetched only once for the whole block.
+func (b *Backend) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		b.logger.Debug("block not found", "height", blockNum, "error", err.Error())
+		return nil, nil
+	}
+	if resBlock == nil {
+		return nil, nil
+	}
+
+	blockRes, err := b.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", resBlock.Block.Height, "error", err.Error())
 		return nil, nil
 	}
+
+	// the chain ID is resolved once for all the receipts of the block
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+	receipts := make([]map[string]interface{}, 0)
+	// ethTxIndex is used as the fallback when the tx index is missing from the events,
+	// it follows the same rules as `EthMsgsFromTendermintBlock`.
+	ethTxIndex := int32(0)
+	for i, txBz := range resBlock.Block.Txs {
+		txResult := blockRes.TxsResults[i]
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(txResult) {
+			continue
+		}
+
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", resBlock.Block.Height, "error", err.Error())
+			continue
+		}
+
+		parsedTxs, err := rpctypes.ParseTxResult(txResult, tx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tx events: block %d, index %d, %v", resBlock.Block.Height, i, err)
+		}
+
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+
+			parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
+			if parsedTx == nil {
+				b.logger.Debug("ethereum tx not found in events", "height", resBlock.Block.Height, "index", i, "msg-index", msgIndex)
+				ethTxIndex++
+				continue
+			}
+
+			res := &ethermint.TxResult{
+				Height:            resBlock.Block.Height,
+				TxIndex:           uint32(i),
+				MsgIndex:          uint32(msgIndex),
+				EthTxIndex:        parsedTx.EthTxIndex,
+				Failed:            parsedTx.Failed,
+				GasUsed:           parsedTx.GasUsed,
+				CumulativeGasUsed: parsedTxs.AccumulativeGasUsed(msgIndex),
+			}
+			if res.EthTxIndex == -1 {
+				res.EthTxIndex = ethTxIndex
+			}
+			ethTxIndex++
+
+			receipt, err := b.formatTxReceipt(ethMsg, res, blockRes, blockHash, chainID.ToInt())
+			if err != nil {
+				return nil, err
+			}
+			receipts = append(receipts, receipt)
+		}
+	}
+
+	return receipts, nil
+}
+
+// formatTxReceipt returns the receipt of an ethereum tx in the json-rpc format,
+// the logs and the cumulative gas used are taken from the block results.
+func (b *Backend) formatTxReceipt(
+	ethMsg *evmtypes.MsgEthereumTx,
+	res *ethermint.TxResult,
+	blockRes *tmrpctypes.ResultBlockResults,
+	blockHash common.Hash,
+	chainID *big.Int,
+) (map[string]interface{}, error) {
+	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	if err != nil {
+		b.logger.Error("failed to unpack tx data", "error", err.Error())
+		return nil, err
+	}
+
+	cumulativeGasUsed := uint64(0)
 	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
 		cumulativeGasUsed += uint64(txResult.GasUsed)
 	}
@@ -179,35 +317,17 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
 	}
 
-	chainID, err := b.ChainID()
+	from, err := ethMsg.GetSender(chainID)
 	if err != nil {
 		return nil, err
 	}
 
-	from, err := ethMsg.GetSender(chainID.ToInt())
-	if err != nil {
-		return nil, err
-	}
+	ethTx := ethMsg.AsTransaction()
 
 	// parse tx logs from events
 	logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, int(res.MsgIndex))
 	if err != nil {
-		b.logger.Debug("failed to parse logs", "hash", hexTx, "error", err.Error())
-	}
-
-	if res.EthTxIndex == -1 {
-		// Fallback to find tx index by iterating all valid eth transactions
-		msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
-		for i := range msgs {
-			if msgs[i].Hash == hexTx {
-				res.EthTxIndex = int32(i)
-				break
-			}
-		}
-	}
-	// return error if still unable to find the eth tx index
-	if res.EthTxIndex == -1 {
-		return nil, errors.New("can't find index of ethereum tx")
+		b.logger.Debug("failed to parse logs", "hash", ethTx.Hash().Hex(), "error", err.Error())
 	}
 
 	receipt := map[string]interface{}{
@@ -219,20 +339,20 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 
 		// Implementation fields: These fields are added by geth when processing a transaction.
 		// They are stored in the chain database.
-		"transactionHash": hash,
+		"transactionHash": ethTx.Hash(),
 		"contractAddress": nil,
 		"gasUsed":         hexutil.Uint64(res.GasUsed),
 
 		// Inclusion information: These fields provide information about the inclusion of the
 		// transaction corresponding to this receipt.
-		"blockHash":        common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
+		"blockHash":        blockHash.Hex(),
 		"blockNumber":      hexutil.Uint64(res.Height),
 		"transactionIndex": hexutil.Uint64(res.EthTxIndex),
 
 		// sender and receiver (contract or EOA) addreses
 		"from": from,
 		"to":   txData.GetTo(),
-		"type": hexutil.Uint(ethMsg.AsTransaction().Type()),
+		"type": hexutil.Uint(ethTx.Type()),
 	}
 
 	if logs == nil {
@@ -296,6 +416,52 @@ func (b *Backend) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNum
 	return b.GetTransactionByBlockAndIndex(block, idx)
 }
 
+// GetTransactionsBySender returns the hashes of the eth txs sent by the address,
+// ordered by block and index, it requires an indexer supporting the queries by
+// address.
+func (b *Backend) GetTransactionsBySender(address common.Address, offset, limit hexutil.Uint) ([]common.Hash, error) {
+	txQueryIndexer, err := b.txQueryIndexer(limit)
+	if err != nil {
+		return nil, err
+	}
+	return txQueryIndexer.GetBySender(address, int(offset), int(limit))
+}
+
+// GetTransactionsByRecipient returns the hashes of the eth txs sent to the
+// address, ordered by block and index, it requires an indexer supporting the
+// queries by address.
+func (b *Backend) GetTransactionsByRecipient(address common.Address, offset, limit hexutil.Uint) ([]common.Hash, error) {
+	txQueryIndexer, err := b.txQueryIndexer(limit)
+	if err != nil {
+		return nil, err
+	}
+	return txQueryIndexer.GetByRecipient(address, int(offset), int(limit))
+}
+
+// GetContractCreationTransaction returns the hash of the eth tx which created
+// the contract at the address, it requires an indexer supporting the queries by
+// address.
+func (b *Backend) GetContractCreationTransaction(address common.Address) (common.Hash, error) {
+	txQueryIndexer, err := b.txQueryIndexer(0)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return txQueryIndexer.GetContractCreation(address)
+}
+
+// txQueryIndexer returns the indexer of the eth txs by address, and checks the
+// number of hashes requested against MaxTxQueryLimit.
+func (b *Backend) txQueryIndexer(limit hexutil.Uint) (ethermint.EVMTxQueryIndexer, error) {
+	txQueryIndexer, ok := b.indexer.(ethermint.EVMTxQueryIndexer)
+	if !ok {
+		return nil, errors.New("the queries of the txs by address require the sql indexer")
+	}
+	if limit > MaxTxQueryLimit {
+		return nil, fmt.Errorf("limit %d exceeds the max of %d", limit, MaxTxQueryLimit)
+	}
+	return txQueryIndexer, nil
+}
+
 // GetTxByEthHash uses `/tx_query` to find transaction by ethereum tx hash
 // TODO: Don't need to convert once hashing is fixed on Tendermint
 // https://github.com/tendermint/tendermint/issues/6539
@@ -3,6 +3,7 @@ package backend
 import (
 	"fmt"
 	"math/big"
+	"path/filepath"
 
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -12,11 +13,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/evmos/ethermint/indexer"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
 	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -598,3 +602,195 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestGetBlockReceipts() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	msgEthereumTx2, _ := suite.buildEthereumTx()
+	txBz2 := suite.signAndEncodeEthTx(msgEthereumTx2)
+	txHash2 := msgEthereumTx2.AsTransaction().Hash()
+	blockNum := rpctypes.BlockNumber(1)
+
+	// txResult returns the result of an ethereum tx with logs at the given
+	// log indexes
+	txResult := func(hash common.Hash, txIndex, gasUsed int64, logIndexes ...uint) *abci.ResponseDeliverTx {
+		logAttrs := make([]abci.EventAttribute, len(logIndexes))
+		for i, logIndex := range logIndexes {
+			logAttrs[i] = abci.EventAttribute{
+				Key:   evmtypes.AttributeKeyTxLog,
+				Value: fmt.Sprintf(`{"address":"0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7","topics":[],"data":"","blockNumber":1,"transactionHash":"%s","transactionIndex":%d,"blockHash":"0x0000000000000000000000000000000000000000000000000000000000000000","logIndex":%d,"removed":false}`, hash.Hex(), txIndex, logIndex),
+			}
+		}
+		return &abci.ResponseDeliverTx{
+			Code:    0,
+			GasUsed: gasUsed,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: hash.Hex()},
+					{Key: "txIndex", Value: fmt.Sprint(txIndex)},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: fmt.Sprint(gasUsed)},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+				}},
+				{Type: evmtypes.EventTypeTxLog, Attributes: logAttrs},
+			},
+		}
+	}
+	registerBlockResults := func(txResults ...*abci.ResponseDeliverTx) {
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		client.On("BlockResults", rpctypes.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+			Return(&tmrpctypes.ResultBlockResults{Height: 1, TxsResults: txResults}, nil)
+	}
+
+	type expReceipt struct {
+		hash              common.Hash
+		gasUsed           uint64
+		cumulativeGasUsed uint64
+		logIndexes        []uint
+	}
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expReceipts  []expReceipt
+		expPass      bool
+	}{
+		{
+			"pass - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			true,
+		},
+		{
+			"pass - block results not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlock(client, 1, txBz)
+				RegisterBlockResultsError(client, 1)
+			},
+			nil,
+			true,
+		},
+		{
+			"pass - one receipt with logs",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBlock(client, 1, txBz)
+				registerBlockResults(txResult(txHash, 0, 21000, 0))
+			},
+			[]expReceipt{{txHash, 21000, 21000, []uint{0}}},
+			true,
+		},
+		{
+			"pass - the gas used and the log indexes accumulate across the txs",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+				_, err := RegisterBlockMultipleTxs(client, 1, []types.Tx{txBz, txBz2})
+				suite.Require().NoError(err)
+				registerBlockResults(txResult(txHash, 0, 21000, 0, 1), txResult(txHash2, 1, 30000, 2))
+			},
+			[]expReceipt{
+				{txHash, 21000, 21000, []uint{0, 1}},
+				{txHash2, 30000, 51000, []uint{2}},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			receipts, err := suite.backend.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(receipts, len(tc.expReceipts))
+				for i, receipt := range receipts {
+					exp := tc.expReceipts[i]
+					suite.Require().Equal(exp.hash, receipt["transactionHash"])
+					suite.Require().Equal(hexutil.Uint64(exp.gasUsed), receipt["gasUsed"])
+					suite.Require().Equal(hexutil.Uint64(exp.cumulativeGasUsed), receipt["cumulativeGasUsed"])
+					suite.Require().Equal(hexutil.Uint64(i), receipt["transactionIndex"])
+					logs := receipt["logs"].([]*ethtypes.Log)
+					suite.Require().Len(logs, len(exp.logIndexes))
+					for j, log := range logs {
+						suite.Require().Equal(exp.logIndexes[j], log.Index)
+						suite.Require().Equal(exp.hash, log.TxHash)
+					}
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetTransactionsByAddress() {
+	msgEthereumTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgEthereumTx)
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	signer := ethtypes.LatestSignerForChainID(suite.backend.chainID)
+	sender, err := ethtypes.Sender(signer, msgEthereumTx.AsTransaction())
+	suite.Require().NoError(err)
+
+	creationTx := evmtypes.NewTx(suite.backend.chainID, 0, nil, big.NewInt(0), 100000, big.NewInt(1), nil, nil, nil, nil)
+	creationBz := suite.signAndEncodeEthTx(creationTx)
+	creationHash := creationTx.AsTransaction().Hash()
+	creator, err := ethtypes.Sender(signer, creationTx.AsTransaction())
+	suite.Require().NoError(err)
+	contract := crypto.CreateAddress(creator, 0)
+
+	txResult := func(hash common.Hash, txIndex string) *abci.ResponseDeliverTx {
+		return &abci.ResponseDeliverTx{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: hash.Hex()},
+					{Key: "txIndex", Value: txIndex},
+					{Key: "amount", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+				}},
+			},
+		}
+	}
+	block := types.MakeBlock(1, []types.Tx{txBz, creationBz}, nil, nil)
+
+	// the kv indexer doesn't index the txs by address
+	_, err = suite.backend.GetTransactionsBySender(sender, 0, 10)
+	suite.Require().Error(err)
+
+	db, err := indexer.OpenSQLIndexerDB(filepath.Join(suite.T().TempDir(), "evmindexer.sqlite"))
+	suite.Require().NoError(err)
+	defer db.Close()
+	sqlIndexer, err := indexer.NewSQLIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(sqlIndexer.IndexBlock(block, []*abci.ResponseDeliverTx{txResult(txHash, "0"), txResult(creationHash, "1")}))
+	suite.backend.indexer = sqlIndexer
+
+	hashes, err := suite.backend.GetTransactionsBySender(sender, 0, 10)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]common.Hash{txHash}, hashes)
+	hashes, err = suite.backend.GetTransactionsByRecipient(common.Address{}, 0, 10)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]common.Hash{txHash}, hashes)
+	hash, err := suite.backend.GetContractCreationTransaction(contract)
+	suite.Require().NoError(err)
+	suite.Require().Equal(creationHash, hash)
+
+	_, err = suite.backend.GetTransactionsBySender(sender, 0, MaxTxQueryLimit+1)
+	suite.Require().Error(err)
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package backend
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// TxPoolTransactions groups the transactions of the mempool by sender and nonce.
+type TxPoolTransactions map[common.Address]map[uint64]*rpctypes.RPCTransaction
+
+// TxPoolContent returns the ethereum transactions currently in the mempool, split
+// into pending and queued ones. A transaction is pending if its nonce is part of
+// a gapless sequence starting at the on-chain nonce of the sender, otherwise it
+// is queued.
+func (b *Backend) TxPoolContent() (pending TxPoolTransactions, queued TxPoolTransactions, err error) {
+	ethMsgs, err := b.pendingEthMsgs()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	all := make(TxPoolTransactions)
+	for _, ethMsg := range ethMsgs {
+		sender, err := ethMsg.GetSender(b.chainID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rpcTx, err := rpctypes.NewTransactionFromMsg(ethMsg, common.Hash{}, uint64(0), uint64(0), nil, b.chainID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if all[sender] == nil {
+			all[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+		}
+		all[sender][uint64(rpcTx.Nonce)] = rpcTx
+	}
+
+	pending = make(TxPoolTransactions)
+	queued = make(TxPoolTransactions)
+	for sender, txsByNonce := range all {
+		res, err := b.queryClient.Account(b.ctx, &evmtypes.QueryAccountRequest{Address: sender.String()})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		nonces := make([]uint64, 0, len(txsByNonce))
+		for nonce := range txsByNonce {
+			nonces = append(nonces, nonce)
+		}
+		sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+		next := res.Nonce
+		for _, nonce := range nonces {
+			target := queued
+			if nonce == next {
+				target = pending
+				next++
+			}
+			if target[sender] == nil {
+				target[sender] = make(map[uint64]*rpctypes.RPCTransaction)
+			}
+			target[sender][nonce] = txsByNonce[nonce]
+		}
+	}
+
+	return pending, queued, nil
+}
+
+// pendingEthMsgs returns the ethereum transactions currently in the mempool, the
+// transactions of every sender are sorted by nonce so they can be applied in order
+// on top of the latest state to query the pending state.
+func (b *Backend) pendingEthMsgs() ([]*evmtypes.MsgEthereumTx, error) {
+	txs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*evmtypes.MsgEthereumTx
+	positions := make(map[common.Address][]int)
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			sender, err := ethMsg.GetSender(b.chainID)
+			if err != nil {
+				b.logger.Debug("failed to get sender of pending tx", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+
+			positions[sender] = append(positions[sender], len(result))
+			result = append(result, ethMsg)
+		}
+	}
+
+	// the mempool may not be ordered by nonce, sort the transactions of every sender
+	// while keeping the positions taken by each sender
+	for _, indexes := range positions {
+		msgs := make([]*evmtypes.MsgEthereumTx, len(indexes))
+		for i, index := range indexes {
+			msgs[i] = result[index]
+		}
+		sort.SliceStable(msgs, func(i, j int) bool { return msgs[i].AsTransaction().Nonce() < msgs[j].AsTransaction().Nonce() })
+		for i, index := range indexes {
+			result[index] = msgs[i]
+		}
+	}
+
+	return result, nil
+}
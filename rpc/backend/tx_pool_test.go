@@ -0,0 +1,99 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/rpc/backend/mocks"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func (suite *BackendTestSuite) TestTxPoolContent() {
+	from, priv := tests.NewAddrKey()
+	signer := tests.NewSigner(priv)
+
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParamsWithoutHeader(queryClient, 1)
+	ethSigner := ethtypes.LatestSigner(suite.backend.ChainConfig())
+
+	buildTx := func(nonce uint64) types.Tx {
+		msg := evmtypes.NewTx(suite.backend.chainID, nonce, &common.Address{}, big.NewInt(0), 100000, big.NewInt(1), nil, nil, nil, nil)
+		msg.From = from.String()
+		suite.Require().NoError(msg.Sign(ethSigner, signer))
+
+		tx, err := msg.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+		suite.Require().NoError(err)
+		bz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+		return bz
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPending   []uint64
+		expQueued    []uint64
+		expPass      bool
+	}{
+		{
+			"fail - mempool error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxsError(client, nil)
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"pass - empty mempool",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterUnconfirmedTxs(client, nil, nil)
+			},
+			nil,
+			nil,
+			true,
+		},
+		{
+			"pass - nonce gap is queued",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				RegisterUnconfirmedTxs(client, nil, []types.Tx{buildTx(3), buildTx(0), buildTx(1)})
+				RegisterAccount(queryClient, from, 1)
+			},
+			[]uint64{0, 1},
+			[]uint64{3},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			pending, queued, err := suite.backend.TxPoolContent()
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(pending[from], len(tc.expPending))
+			for _, nonce := range tc.expPending {
+				suite.Require().Contains(pending[from], nonce)
+			}
+			suite.Require().Len(queued[from], len(tc.expQueued))
+			for _, nonce := range tc.expQueued {
+				suite.Require().Contains(queued[from], nonce)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// backfilledLogs are the logs replayed from the chain for a logs subscription,
+// from its fromBlock up to the head block.
+type backfilledLogs struct {
+	logs []*ethtypes.Log
+	head int64
+}
+
+// logsBackfill sequences the notifications of a logs subscription which replays
+// the logs of past blocks before the live ones.
+//
+// The live logs are subscribed to before the latest block is queried, so every
+// block past the backfill head is notified by the live events, and the blocks up
+// to the head are replayed from the chain. The boundary is the head block:
+//   - the live logs received before the replay is sent are queued, the ones of
+//     the blocks past the head are sent right after the replayed logs.
+//   - the live logs received after the replay is sent are sent only if they are
+//     past the head.
+//
+// The live logs of the blocks up to the head are dropped as they are part of
+// the replay, so the client receives every log exactly once and in block order.
+// Without replay the head is -1 and all the live logs are sent.
+type logsBackfill struct {
+	head   int64
+	sent   bool
+	queued []*ethtypes.Log
+}
+
+// onLive returns the live logs to notify.
+func (b *logsBackfill) onLive(logs []*ethtypes.Log) []*ethtypes.Log {
+	if !b.sent {
+		b.queued = append(b.queued, logs...)
+		return nil
+	}
+	return b.pastHead(logs)
+}
+
+// onBackfill returns the replayed logs followed by the queued live logs past
+// the head of the replay.
+func (b *logsBackfill) onBackfill(logs []*ethtypes.Log, head int64) []*ethtypes.Log {
+	b.head = head
+	b.sent = true
+
+	res := make([]*ethtypes.Log, 0, len(logs)+len(b.queued))
+	res = append(res, logs...)
+	res = append(res, b.pastHead(b.queued)...)
+	b.queued = nil
+	return res
+}
+
+func (b *logsBackfill) pastHead(logs []*ethtypes.Log) []*ethtypes.Log {
+	res := make([]*ethtypes.Log, 0, len(logs))
+	for _, log := range logs {
+		if int64(log.BlockNumber) > b.head {
+			res = append(res, log)
+		}
+	}
+	return res
+}
@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func logsAt(heights ...uint64) []*ethtypes.Log {
+	logs := make([]*ethtypes.Log, len(heights))
+	for i, height := range heights {
+		logs[i] = &ethtypes.Log{BlockNumber: height, Index: uint(i)}
+	}
+	return logs
+}
+
+func blockNumbers(logs []*ethtypes.Log) []uint64 {
+	heights := make([]uint64, len(logs))
+	for i, log := range logs {
+		heights[i] = log.BlockNumber
+	}
+	return heights
+}
+
+func TestLogsBackfill(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(b *logsBackfill) []*ethtypes.Log
+		expected []uint64
+	}{
+		{
+			"live logs are held until the replay is sent",
+			func(b *logsBackfill) []*ethtypes.Log {
+				return b.onLive(logsAt(11))
+			},
+			[]uint64{},
+		},
+		{
+			"queued live logs past the head follow the replay",
+			func(b *logsBackfill) []*ethtypes.Log {
+				b.onLive(logsAt(11))
+				b.onLive(logsAt(12))
+				return b.onBackfill(logsAt(5, 8, 10), 10)
+			},
+			[]uint64{5, 8, 10, 11, 12},
+		},
+		{
+			"queued live logs of the head block are dropped",
+			func(b *logsBackfill) []*ethtypes.Log {
+				b.onLive(logsAt(9, 10))
+				b.onLive(logsAt(11))
+				return b.onBackfill(logsAt(9, 10), 10)
+			},
+			[]uint64{9, 10, 11},
+		},
+		{
+			"live logs up to the head are dropped after the replay",
+			func(b *logsBackfill) []*ethtypes.Log {
+				b.onBackfill(logsAt(10), 10)
+				return b.onLive(logsAt(10))
+			},
+			[]uint64{},
+		},
+		{
+			"live logs past the head are sent after the replay",
+			func(b *logsBackfill) []*ethtypes.Log {
+				b.onBackfill(logsAt(10), 10)
+				return b.onLive(logsAt(11, 11))
+			},
+			[]uint64{11, 11},
+		},
+		{
+			"without replay all the live logs are sent",
+			func(b *logsBackfill) []*ethtypes.Log {
+				b.onLive(logsAt(1))
+				res := b.onBackfill(nil, -1)
+				return append(res, b.onLive(logsAt(2))...)
+			},
+			[]uint64{1, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var b logsBackfill
+			require.Equal(t, tc.expected, blockNumbers(tc.malleate(&b)))
+		})
+	}
+}
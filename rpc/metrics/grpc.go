@@ -0,0 +1,50 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package metrics
+
+import (
+	"context"
+	"time"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/ethereum/go-ethereum/metrics"
+	"google.golang.org/grpc"
+)
+
+// instrumentedConn records the latency of the gRPC queries to the app.
+type instrumentedConn struct {
+	gogogrpc.ClientConn
+}
+
+// InstrumentClientConn wraps the gRPC connection to record the latency and
+// the errors of its queries.
+func InstrumentClientConn(conn gogogrpc.ClientConn) gogogrpc.ClientConn {
+	return instrumentedConn{ClientConn: conn}
+}
+
+// Invoke performs the unary query and records its latency under the name of
+// the gRPC method, eg. rpc/grpc/ethermint_evm_v1_Query/EthCall.
+func (c instrumentedConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := c.ClientConn.Invoke(ctx, method, args, reply, opts...)
+
+	name := "rpc/grpc" + metricName(method)
+	metrics.GetOrRegisterTimer(name, nil).UpdateSince(start)
+	if err != nil {
+		metrics.GetOrRegisterCounter(name+"/errors", nil).Inc(1)
+	}
+	return err
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/evmos/ethermint/rpc/middleware"
+)
+
+// maxCapturedResponse is the max size of the responses decoded to find the
+// error codes, the bigger ones are results.
+const maxCapturedResponse = 1024 * 1024
+
+// responseRecorder captures the start of the response written to the client.
+type responseRecorder struct {
+	http.ResponseWriter
+	body      bytes.Buffer
+	truncated bool
+}
+
+func (r *responseRecorder) Write(bz []byte) (int, error) {
+	if !r.truncated {
+		if r.body.Len()+len(bz) > maxCapturedResponse {
+			r.truncated = true
+		} else {
+			r.body.Write(bz)
+		}
+	}
+	return r.ResponseWriter.Write(bz)
+}
+
+// response is the part of a JSON-RPC response the metrics need.
+type response struct {
+	ID    json.RawMessage `json:"id"`
+	Error *struct {
+		Code int `json:"code"`
+	} `json:"error"`
+}
+
+// Handler returns a middleware recording the calls of the JSON-RPC requests,
+// the calls of a batch are recorded with the latency of the whole batch. The
+// calls of the methods which aren't in the given set are recorded as unknown.
+func Handler(next http.Handler, methods map[string]bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		reqs, _, err := middleware.ReadRequests(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		rec := &responseRecorder{ResponseWriter: w}
+		start := time.Now()
+		next.ServeHTTP(rec, r)
+		duration := time.Since(start)
+
+		errCodes := make(map[string]int)
+		if !rec.truncated {
+			for _, res := range decodeResponses(rec.body.Bytes()) {
+				if res.Error != nil {
+					errCodes[string(res.ID)] = res.Error.Code
+				}
+			}
+		}
+
+		for _, req := range reqs {
+			method := req.Method
+			if !methods[method] {
+				method = unknownMethod
+			}
+			RecordCall(method, duration, errCodes[string(req.ID)])
+		}
+	})
+}
+
+// decodeResponses decodes the single or batch JSON-RPC response.
+func decodeResponses(body []byte) []response {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	if len(body) > 0 && body[0] == '[' {
+		var responses []response
+		if err := json.Unmarshal(body, &responses); err != nil {
+			return nil
+		}
+		return responses
+	}
+
+	var res response
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil
+	}
+	return []response{res}
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+// Package metrics records the metrics of the JSON-RPC server in the
+// go-ethereum metrics registry, served by the JSON-RPC metrics server.
+package metrics
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// unknownMethod is the method name the calls of the methods which aren't
+// registered are recorded under, to bound the number of metrics.
+const unknownMethod = "unknown"
+
+// Methods returns the names of the JSON-RPC methods of the APIs, formatted
+// like the go-ethereum server registers them.
+func Methods(apis []ethrpc.API) map[string]bool {
+	methods := make(map[string]bool)
+	for _, api := range apis {
+		methods[api.Namespace+"_subscribe"] = true
+		methods[api.Namespace+"_unsubscribe"] = true
+
+		typ := reflect.TypeOf(api.Service)
+		for i := 0; i < typ.NumMethod(); i++ {
+			name := []rune(typ.Method(i).Name)
+			name[0] = unicode.ToLower(name[0])
+			methods[api.Namespace+"_"+string(name)] = true
+		}
+	}
+	return methods
+}
+
+// RecordCall records a call of the method, served in the given duration. The
+// error code is 0 if the call succeeded.
+func RecordCall(method string, duration time.Duration, errCode int) {
+	prefix := "rpc/calls/" + metricName(method)
+	metrics.GetOrRegisterCounter(prefix+"/requests", nil).Inc(1)
+	metrics.GetOrRegisterTimer(prefix+"/duration", nil).Update(duration)
+	if errCode == 0 {
+		return
+	}
+
+	metrics.GetOrRegisterCounter(prefix+"/errors", nil).Inc(1)
+	// the JSON-RPC error codes are negative, the sign isn't a valid character of
+	// the metric names
+	if errCode < 0 {
+		errCode = -errCode
+	}
+	metrics.GetOrRegisterCounter(fmt.Sprintf("rpc/errors/%d", errCode), nil).Inc(1)
+}
+
+// TrackSubscription increments the gauge of the active subscriptions of the
+// given type. It returns the function decrementing it once the subscription
+// is cancelled, which is safe to call more than once.
+func TrackSubscription(typ string) func() {
+	gauge := metrics.GetOrRegisterGauge("rpc/subscriptions/"+metricName(typ), nil)
+	gauge.Inc(1)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			gauge.Dec(1)
+		})
+	}
+}
+
+// metricName replaces the characters which aren't valid in a metric name.
+func metricName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, name)
+}
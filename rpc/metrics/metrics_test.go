@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/metrics/prometheus"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type testService struct{}
+
+func (testService) ChainId() string { return "0x1" }
+
+func (testService) GetBalance(string) (string, error) { return "", errors.New("failed") }
+
+type testConn struct {
+	err error
+}
+
+func (c testConn) Invoke(context.Context, string, interface{}, interface{}, ...grpc.CallOption) error {
+	return c.err
+}
+
+func (testConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, nil
+}
+
+// scrape returns the metrics served by the metrics server in the Prometheus
+// format.
+func scrape(t *testing.T) string {
+	srv := httptest.NewServer(prometheus.Handler(metrics.DefaultRegistry))
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestMethods(t *testing.T) {
+	methods := Methods([]ethrpc.API{{Namespace: "eth", Service: testService{}}})
+	require.Equal(t, map[string]bool{
+		"eth_chainId":     true,
+		"eth_getBalance":  true,
+		"eth_subscribe":   true,
+		"eth_unsubscribe": true,
+	}, methods)
+}
+
+func TestMetricsEndpoint(t *testing.T) {
+	enabled := metrics.Enabled
+	metrics.Enabled = true
+	t.Cleanup(func() { metrics.Enabled = enabled })
+
+	apis := []ethrpc.API{{Namespace: "eth", Service: testService{}}}
+	server := ethrpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", testService{}))
+	handler := Handler(server, Methods(apis))
+
+	post := func(body string) {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		require.Equal(t, http.StatusOK, w.Code)
+	}
+	post(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`)
+	post(`[{"jsonrpc":"2.0","id":2,"method":"eth_chainId"},{"jsonrpc":"2.0","id":3,"method":"eth_getBalance","params":["0x0"]}]`)
+	post(`{"jsonrpc":"2.0","id":4,"method":"eth_notFound"}`)
+
+	untrack := TrackSubscription("newHeads")
+	TrackSubscription("newHeads")()
+	untrack()
+	untrack()
+	TrackSubscription("logs")
+
+	conn := InstrumentClientConn(testConn{})
+	require.NoError(t, conn.Invoke(context.Background(), "/ethermint.evm.v1.Query/Params", nil, nil))
+	conn = InstrumentClientConn(testConn{err: errors.New("failed")})
+	require.Error(t, conn.Invoke(context.Background(), "/ethermint.evm.v1.Query/Params", nil, nil))
+
+	body := scrape(t)
+	for _, line := range []string{
+		"rpc_calls_eth_chainId_requests gauge\nrpc_calls_eth_chainId_requests 2\n",
+		"rpc_calls_eth_chainId_duration_count 2\n",
+		"rpc_calls_eth_getBalance_errors gauge\nrpc_calls_eth_getBalance_errors 1\n",
+		"rpc_calls_unknown_requests gauge\nrpc_calls_unknown_requests 1\n",
+		"rpc_calls_unknown_errors gauge\nrpc_calls_unknown_errors 1\n",
+		"rpc_errors_32000 gauge\nrpc_errors_32000 1\n",
+		"rpc_errors_32601 gauge\nrpc_errors_32601 1\n",
+		"rpc_subscriptions_newHeads gauge\nrpc_subscriptions_newHeads 0\n",
+		"rpc_subscriptions_logs gauge\nrpc_subscriptions_logs 1\n",
+		"rpc_grpc_ethermint_evm_v1_Query_Params_count 2\n",
+		"rpc_grpc_ethermint_evm_v1_Query_Params_errors gauge\nrpc_grpc_ethermint_evm_v1_Query_Params_errors 1\n",
+	} {
+		require.Contains(t, body, line)
+	}
+	require.NotContains(t, body, "rpc_calls_eth_chainId_errors")
+}
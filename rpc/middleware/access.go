@@ -0,0 +1,258 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// jwtExpiryTimeout is the max difference between the issuance time of the
+	// tokens and the current time, same as the go-ethereum authenticated RPC.
+	jwtExpiryTimeout = 60 * time.Second
+
+	// jwtSecretLength is the length in bytes of the JWT secret
+	jwtSecretLength = 32
+)
+
+var (
+	// ErrMissingToken is returned when a method of a non-public namespace is
+	// called without a JWT.
+	ErrMissingToken = errors.New("missing authorization token")
+	// ErrInvalidToken is returned when a request is sent with an invalid JWT.
+	ErrInvalidToken = errors.New("invalid authorization token")
+
+	// jwtHeader is the header of the tokens, only HS256 is supported
+	jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+)
+
+// AccessControl restricts the methods which can be called with allow and deny
+// lists, and the methods of the non-public namespaces to the clients sending
+// a JWT signed with the secret, if any.
+type AccessControl struct {
+	allowed []string
+	denied  []string
+	private map[string]bool
+
+	jwtSecret []byte
+	now       func() time.Time
+}
+
+// NewAccessControl creates the access control of the given method lists. The
+// allow list is ignored if empty, the deny list has precedence over it. The
+// private namespaces require a JWT if the secret isn't empty.
+func NewAccessControl(allowed, denied, privateNamespaces []string, jwtSecret []byte) *AccessControl {
+	private := make(map[string]bool, len(privateNamespaces))
+	for _, ns := range privateNamespaces {
+		private[ns] = true
+	}
+
+	return &AccessControl{
+		allowed:   allowed,
+		denied:    denied,
+		private:   private,
+		jwtSecret: jwtSecret,
+		now:       time.Now,
+	}
+}
+
+// Authenticate returns true if the request is sent with a valid JWT in the
+// Authorization header, and an error if it's sent with an invalid one.
+func (a *AccessControl) Authenticate(r *http.Request) (bool, error) {
+	auth := r.Header.Get("Authorization")
+	if auth == "" || len(a.jwtSecret) == 0 {
+		return false, nil
+	}
+
+	token, ok := strings.CutPrefix(auth, "Bearer ")
+	if !ok {
+		return false, ErrInvalidToken
+	}
+	if err := a.verifyToken(token); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CheckMethod returns the JSON-RPC error code and the error if the method
+// can't be called by the client.
+func (a *AccessControl) CheckMethod(method string, authenticated bool) (int, error) {
+	for _, pattern := range a.denied {
+		if MatchMethod(pattern, method) {
+			return ErrCodeMethodNotFound, fmt.Errorf("the method %s is not available", method)
+		}
+	}
+
+	allowed := len(a.allowed) == 0
+	for _, pattern := range a.allowed {
+		if MatchMethod(pattern, method) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return ErrCodeMethodNotFound, fmt.Errorf("the method %s is not available", method)
+	}
+
+	namespace, _, _ := strings.Cut(method, "_")
+	if len(a.jwtSecret) > 0 && a.private[namespace] && !authenticated {
+		return ErrCodeUnauthorized, ErrMissingToken
+	}
+	return 0, nil
+}
+
+// Token returns a new JWT signed with the secret, used to forward the requests
+// of an authenticated client.
+func (a *AccessControl) Token() string {
+	claims := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"iat":%d}`, a.now().Unix())))
+	return jwtHeader + "." + claims + "." + a.sign(jwtHeader+"."+claims)
+}
+
+// Handler returns a middleware restricting the methods of the JSON-RPC
+// requests. The whole batch is rejected if one of its methods can't be called.
+// The WebSocket upgrades are let through, the messages of the connections are
+// checked by the WebSocket server with the token sent on upgrade.
+func (a *AccessControl) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authenticated, err := a.Authenticate(r)
+		if err != nil {
+			WriteErrors(w, http.StatusUnauthorized, nil, false, ErrCodeUnauthorized, err.Error())
+			return
+		}
+
+		if r.Method != http.MethodPost {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		reqs, batch, err := ReadRequests(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		for _, req := range reqs {
+			code, err := a.CheckMethod(req.Method, authenticated)
+			if err == nil {
+				continue
+			}
+
+			status := http.StatusOK
+			if code == ErrCodeUnauthorized {
+				status = http.StatusUnauthorized
+			}
+			WriteErrors(w, status, reqs, batch, code, err.Error())
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// verifyToken checks the signature and the issuance time of the HS256 token.
+func (a *AccessControl) verifyToken(token string) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ErrInvalidToken
+	}
+
+	headerBz, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return ErrInvalidToken
+	}
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := json.Unmarshal(headerBz, &header); err != nil || header.Alg != "HS256" {
+		return ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return ErrInvalidToken
+	}
+	expected, _ := base64.RawURLEncoding.DecodeString(a.sign(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, expected) {
+		return ErrInvalidToken
+	}
+
+	claimsBz, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ErrInvalidToken
+	}
+	var claims struct {
+		IssuedAt *int64 `json:"iat"`
+	}
+	if err := json.Unmarshal(claimsBz, &claims); err != nil || claims.IssuedAt == nil {
+		return ErrInvalidToken
+	}
+
+	issuedAt := time.Unix(*claims.IssuedAt, 0)
+	if diff := a.now().Sub(issuedAt); diff > jwtExpiryTimeout || diff < -jwtExpiryTimeout {
+		return fmt.Errorf("%w: stale token", ErrInvalidToken)
+	}
+	return nil
+}
+
+// sign returns the encoded HMAC-SHA256 signature of the data.
+func (a *AccessControl) sign(data string) string {
+	mac := hmac.New(sha256.New, a.jwtSecret)
+	mac.Write([]byte(data))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// LoadJWTSecret reads the hex encoded JWT secret of the file, like the
+// go-ethereum authenticated RPC. A random secret is generated and written to
+// the file if it doesn't exist.
+func LoadJWTSecret(path string) ([]byte, error) {
+	bz, err := os.ReadFile(path)
+	if err == nil {
+		secret, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(bz)), "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWT secret in %s: %w", path, err)
+		}
+		if len(secret) != jwtSecretLength {
+			return nil, fmt.Errorf("invalid JWT secret in %s, expected %d bytes, got %d", path, jwtSecretLength, len(secret))
+		}
+		return secret, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	secret := make([]byte, jwtSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, []byte(hex.EncodeToString(secret)), 0o600); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
@@ -0,0 +1,145 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testSecret = bytes.Repeat([]byte{1}, jwtSecretLength)
+
+func TestCheckMethod(t *testing.T) {
+	testCases := []struct {
+		name          string
+		allowed       []string
+		denied        []string
+		secret        []byte
+		method        string
+		authenticated bool
+		expCode       int
+	}{
+		{"no restriction", nil, nil, nil, "debug_cpuProfile", false, 0},
+		{"denied", nil, []string{"debug_cpuProfile"}, nil, "debug_cpuProfile", false, ErrCodeMethodNotFound},
+		{"denied wildcard", nil, []string{"debug_*"}, nil, "debug_traceTransaction", false, ErrCodeMethodNotFound},
+		{"allowed", []string{"eth_*", "debug_trace*"}, nil, nil, "debug_traceTransaction", false, 0},
+		{"not allowed", []string{"eth_*", "debug_trace*"}, nil, nil, "debug_cpuProfile", false, ErrCodeMethodNotFound},
+		{"denied over allowed", []string{"debug_*"}, []string{"debug_writeMemProfile"}, nil, "debug_writeMemProfile", false, ErrCodeMethodNotFound},
+		{"private without secret", nil, nil, nil, "personal_listAccounts", false, 0},
+		{"private without token", nil, nil, testSecret, "personal_listAccounts", false, ErrCodeUnauthorized},
+		{"private with token", nil, nil, testSecret, "personal_listAccounts", true, 0},
+		{"public without token", nil, nil, testSecret, "eth_chainId", false, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := NewAccessControl(tc.allowed, tc.denied, []string{"personal", "miner"}, tc.secret)
+			code, err := a.CheckMethod(tc.method, tc.authenticated)
+			require.Equal(t, tc.expCode, code)
+			require.Equal(t, tc.expCode != 0, err != nil)
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	a := NewAccessControl(nil, nil, nil, testSecret)
+	a.now = func() time.Time { return now }
+	token := a.Token()
+
+	other := NewAccessControl(nil, nil, nil, bytes.Repeat([]byte{2}, jwtSecretLength))
+	other.now = a.now
+
+	testCases := []struct {
+		name     string
+		auth     string
+		advance  time.Duration
+		expAuth  bool
+		expError bool
+	}{
+		{"no token", "", 0, false, false},
+		{"valid token", "Bearer " + token, 0, true, false},
+		{"valid token within the expiry", "Bearer " + token, 59 * time.Second, true, false},
+		{"stale token", "Bearer " + token, 61 * time.Second, false, true},
+		{"token issued in the future", "Bearer " + token, -61 * time.Second, false, true},
+		{"wrong secret", "Bearer " + other.Token(), 0, false, true},
+		{"not a bearer token", token, 0, false, true},
+		{"malformed token", "Bearer abc", 0, false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a.now = func() time.Time { return now.Add(tc.advance) }
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tc.auth != "" {
+				r.Header.Set("Authorization", tc.auth)
+			}
+
+			authenticated, err := a.Authenticate(r)
+			require.Equal(t, tc.expAuth, authenticated)
+			require.Equal(t, tc.expError, err != nil)
+		})
+	}
+}
+
+func TestAccessControlHandler(t *testing.T) {
+	a := NewAccessControl(nil, []string{"debug_cpuProfile"}, []string{"personal"}, testSecret)
+	handler := a.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	post := func(body, auth string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		if auth != "" {
+			r.Header.Set("Authorization", auth)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := post(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`, "")
+	require.Equal(t, "ok", w.Body.String())
+
+	w = post(`{"jsonrpc":"2.0","id":1,"method":"debug_cpuProfile"}`, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), "-32601")
+
+	w = post(`[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"personal_listAccounts"}]`, "")
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.True(t, strings.HasPrefix(w.Body.String(), "["))
+
+	w = post(`{"jsonrpc":"2.0","id":1,"method":"personal_listAccounts"}`, "Bearer "+a.Token())
+	require.Equal(t, "ok", w.Body.String())
+
+	w = post(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`, "Bearer invalid")
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestLoadJWTSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config", "jwt.hex")
+
+	// generated if missing
+	secret, err := LoadJWTSecret(path)
+	require.NoError(t, err)
+	require.Len(t, secret, jwtSecretLength)
+
+	loaded, err := LoadJWTSecret(path)
+	require.NoError(t, err)
+	require.Equal(t, secret, loaded)
+
+	require.NoError(t, os.WriteFile(path, []byte("0x"+hex.EncodeToString(testSecret)+"\n"), 0o600))
+	loaded, err = LoadJWTSecret(path)
+	require.NoError(t, err)
+	require.Equal(t, testSecret, loaded)
+
+	require.NoError(t, os.WriteFile(path, []byte("abcd"), 0o600))
+	_, err = LoadJWTSecret(path)
+	require.Error(t, err)
+}
@@ -0,0 +1,296 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+const (
+	// ErrCodeInvalidRequest is the JSON-RPC error code of the batches with too
+	// many requests.
+	ErrCodeInvalidRequest = -32600
+	// ErrCodeResponseTooLarge is the JSON-RPC error code of the requests of a
+	// batch which aren't served once the batch response is too large, same as
+	// go-ethereum.
+	ErrCodeResponseTooLarge = -32003
+)
+
+var (
+	// readOnlyMethods are the methods which don't change the state of the node,
+	// the requests of a batch calling them can be served in any order.
+	readOnlyMethods = []string{"eth_*", "net_*", "web3_*", "txpool_*", "trace_*", "debug_trace*"}
+	// statefulMethods are the methods matching the read-only ones which depend
+	// on the order of the requests, eg. the filters.
+	statefulMethods = []string{
+		"eth_send*", "eth_sign*", "eth_resend",
+		"eth_newFilter", "eth_newBlockFilter", "eth_newPendingTransactionFilter",
+		"eth_uninstallFilter", "eth_getFilterChanges",
+		"eth_subscribe", "eth_unsubscribe",
+	}
+)
+
+// BatchConfig defines the limits of the batch requests and how they are served.
+type BatchConfig struct {
+	// RequestLimit is the max number of requests of a batch (0 = unlimited).
+	RequestLimit int
+	// ResponseMaxSize is the max size in bytes of the responses of a batch, the
+	// requests served once it's exceeded reply with an error (0 = unlimited).
+	ResponseMaxSize int
+	// Workers is the max number of read-only requests served concurrently, over
+	// all the batches (0 = sequentially).
+	Workers int
+}
+
+// batchHandler enforces the limits of the batch requests.
+type batchHandler struct {
+	next    http.Handler
+	cfg     BatchConfig
+	workers chan struct{}
+}
+
+// BatchHandler returns a middleware enforcing the limits of the batch requests.
+// The batches are split in single requests served by the next handler, in
+// order, except the consecutive read-only requests which are served
+// concurrently if workers are configured.
+func BatchHandler(next http.Handler, cfg BatchConfig) http.Handler {
+	h := &batchHandler{next: next, cfg: cfg}
+	if cfg.Workers > 0 {
+		h.workers = make(chan struct{}, cfg.Workers)
+	}
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *batchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.Body == nil {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+
+	var msgs []json.RawMessage
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	if len(trimmed) == 0 || trimmed[0] != '[' || json.Unmarshal(trimmed, &msgs) != nil || len(msgs) == 0 {
+		// let the server reply to the single and invalid requests
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	reqs := make([]Request, len(msgs))
+	for i, msg := range msgs {
+		// the invalid requests have no method, the server replies to them
+		_ = json.Unmarshal(msg, &reqs[i])
+	}
+
+	if h.cfg.RequestLimit > 0 && len(reqs) > h.cfg.RequestLimit {
+		WriteErrors(w, http.StatusOK, reqs, true, ErrCodeInvalidRequest, fmt.Sprintf("batch too large, max %d requests", h.cfg.RequestLimit))
+		return
+	}
+
+	if h.cfg.ResponseMaxSize == 0 && h.workers == nil {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	h.serveBatch(w, r, msgs, reqs)
+}
+
+// batchResponse is the response of a request of a batch, the requests which
+// aren't served once the max response size is exceeded have none.
+type batchResponse struct {
+	served bool
+	status int
+	body   []byte
+}
+
+// batchSize is the size of the responses of a batch served so far, updated by
+// the requests served concurrently.
+type batchSize struct {
+	mu   sync.Mutex
+	size int
+	max  int
+}
+
+func (s *batchSize) add(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.size += n
+}
+
+// exceeded returns true if the max size is exceeded, the next requests of the
+// batch aren't served.
+func (s *batchSize) exceeded() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.max > 0 && s.size > s.max
+}
+
+// serveBatch serves the requests of the batch one by one, or concurrently for
+// the consecutive read-only ones, until the max response size is exceeded.
+func (h *batchHandler) serveBatch(w http.ResponseWriter, r *http.Request, msgs []json.RawMessage, reqs []Request) {
+	responses := make([]batchResponse, len(msgs))
+	size := &batchSize{max: h.cfg.ResponseMaxSize}
+	for start := 0; start < len(msgs) && !size.exceeded(); {
+		end := start + 1
+		if h.workers != nil && isReadOnly(reqs[start].Method) {
+			for end < len(msgs) && isReadOnly(reqs[end].Method) {
+				end++
+			}
+		}
+		h.serveRequests(r, msgs, responses, start, end, size)
+
+		for _, res := range responses[start:end] {
+			if res.served && res.status != http.StatusOK {
+				// the whole batch is invalid, eg. the content type
+				w.WriteHeader(res.status)
+				_, _ = w.Write(res.body)
+				return
+			}
+		}
+		start = end
+	}
+
+	results := make([][]byte, 0, len(msgs))
+	for i := range msgs {
+		if !responses[i].served {
+			bz, err := json.Marshal(errorResponse{
+				JSONRPC: "2.0",
+				ID:      reqs[i].ID,
+				Error:   errorObject{Code: ErrCodeResponseTooLarge, Message: "response too large"},
+			})
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			results = append(results, bz)
+			continue
+		}
+		// the notifications have no response
+		if body := bytes.TrimSpace(responses[i].body); len(body) > 0 {
+			results = append(results, body)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if len(results) == 0 {
+		return
+	}
+	_, _ = w.Write([]byte("["))
+	_, _ = w.Write(bytes.Join(results, []byte(",")))
+	_, _ = w.Write([]byte("]"))
+}
+
+// serveRequests serves the requests in the given range, concurrently if it has
+// more than one request, until the max response size is exceeded. The requests
+// which can't get a worker are served by the calling goroutine, to bound the
+// number of goroutines.
+func (h *batchHandler) serveRequests(r *http.Request, msgs []json.RawMessage, responses []batchResponse, start, end int, size *batchSize) {
+	serve := func(i int) {
+		responses[i] = h.serveRequest(r, msgs[i])
+		size.add(len(responses[i].body))
+	}
+
+	if end-start == 1 {
+		serve(start)
+		return
+	}
+
+	var wg sync.WaitGroup
+	for i := start; i < end && !size.exceeded(); i++ {
+		select {
+		case h.workers <- struct{}{}:
+			wg.Add(1)
+			go func(i int) {
+				defer func() {
+					<-h.workers
+					wg.Done()
+				}()
+				serve(i)
+			}(i)
+		default:
+			serve(i)
+		}
+	}
+	wg.Wait()
+}
+
+// serveRequest serves a single request of the batch with the headers of the
+// batch request.
+func (h *batchHandler) serveRequest(r *http.Request, msg json.RawMessage) batchResponse {
+	req := r.Clone(r.Context())
+	req.Body = io.NopCloser(bytes.NewReader(msg))
+	req.ContentLength = int64(len(msg))
+
+	w := &bufferedResponse{header: http.Header{}}
+	h.next.ServeHTTP(w, req)
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return batchResponse{served: true, status: w.status, body: w.body.Bytes()}
+}
+
+// bufferedResponse is a http.ResponseWriter buffering the response of a request
+// of a batch.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedResponse) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedResponse) Write(bz []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(bz)
+}
+
+func (w *bufferedResponse) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+// isReadOnly returns true if the method doesn't depend on the order of the
+// requests of a batch.
+func isReadOnly(method string) bool {
+	for _, pattern := range statefulMethods {
+		if MatchMethod(pattern, method) {
+			return false
+		}
+	}
+	for _, pattern := range readOnlyMethods {
+		if MatchMethod(pattern, method) {
+			return true
+		}
+	}
+	return false
+}
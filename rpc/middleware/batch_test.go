@@ -0,0 +1,182 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type batchTestService struct {
+	arrived sync.WaitGroup
+	echoed  atomic.Int32
+}
+
+func (s *batchTestService) Echo(value string) string {
+	s.echoed.Add(1)
+	return value
+}
+
+// Wait returns true once the given number of calls are served concurrently.
+func (s *batchTestService) Wait() bool {
+	s.arrived.Done()
+	done := make(chan struct{})
+	go func() {
+		s.arrived.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(time.Second):
+		return false
+	}
+}
+
+type batchTestResponse struct {
+	ID     json.RawMessage `json:"id"`
+	Result interface{}     `json:"result"`
+	Error  *errorObject    `json:"error"`
+}
+
+func newBatchTestHandler(t *testing.T, cfg BatchConfig) (http.Handler, *batchTestService) {
+	service := &batchTestService{}
+	server := ethrpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", service))
+	t.Cleanup(server.Stop)
+	return BatchHandler(server, cfg), service
+}
+
+func postBatch(t *testing.T, handler http.Handler, body string) (int, []batchTestResponse) {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	var responses []batchTestResponse
+	if w.Body.Len() > 0 {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &responses), w.Body.String())
+	}
+	return w.Code, responses
+}
+
+func TestBatchHandlerRequestLimit(t *testing.T) {
+	handler, _ := newBatchTestHandler(t, BatchConfig{RequestLimit: 2})
+
+	code, responses := postBatch(t, handler, `[{"jsonrpc":"2.0","id":1,"method":"eth_echo","params":["a"]},{"jsonrpc":"2.0","id":2,"method":"eth_echo","params":["b"]}]`)
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, responses, 2)
+	require.Equal(t, "a", responses[0].Result)
+	require.Equal(t, "b", responses[1].Result)
+
+	_, responses = postBatch(t, handler, `[{"jsonrpc":"2.0","id":1,"method":"eth_echo","params":["a"]},{"jsonrpc":"2.0","id":2,"method":"eth_echo","params":["b"]},{"jsonrpc":"2.0","id":3,"method":"eth_echo","params":["c"]}]`)
+	require.Len(t, responses, 3)
+	for i, res := range responses {
+		require.Equal(t, json.RawMessage(string(rune('1'+i))), res.ID)
+		require.NotNil(t, res.Error)
+		require.Equal(t, ErrCodeInvalidRequest, res.Error.Code)
+	}
+}
+
+func TestBatchHandlerResponseMaxSize(t *testing.T) {
+	handler, _ := newBatchTestHandler(t, BatchConfig{ResponseMaxSize: 60})
+	value := strings.Repeat("x", 50)
+
+	_, responses := postBatch(t, handler, `[
+		{"jsonrpc":"2.0","id":1,"method":"eth_echo","params":["a"]},
+		{"jsonrpc":"2.0","method":"eth_echo","params":["notification"]},
+		{"jsonrpc":"2.0","id":2,"method":"eth_echo","params":["`+value+`"]},
+		{"jsonrpc":"2.0","id":3,"method":"eth_echo","params":["c"]},
+		{"jsonrpc":"2.0","id":4,"method":"eth_echo","params":["d"]}
+	]`)
+
+	// the response which exceeds the max size is sent, the next ones aren't served
+	require.Len(t, responses, 4)
+	require.Equal(t, "a", responses[0].Result)
+	require.Equal(t, value, responses[1].Result)
+	for _, res := range responses[2:] {
+		require.NotNil(t, res.Error)
+		require.Equal(t, ErrCodeResponseTooLarge, res.Error.Code)
+	}
+	require.Equal(t, json.RawMessage("3"), responses[2].ID)
+	require.Equal(t, json.RawMessage("4"), responses[3].ID)
+}
+
+func TestBatchHandlerWorkers(t *testing.T) {
+	handler, service := newBatchTestHandler(t, BatchConfig{Workers: 3})
+
+	// the calls only succeed if they are served concurrently
+	service.arrived.Add(3)
+	_, responses := postBatch(t, handler, `[{"jsonrpc":"2.0","id":1,"method":"eth_wait"},{"jsonrpc":"2.0","id":2,"method":"eth_wait"},{"jsonrpc":"2.0","id":3,"method":"eth_wait"}]`)
+	require.Len(t, responses, 3)
+	for i, res := range responses {
+		require.Equal(t, json.RawMessage(string(rune('1'+i))), res.ID)
+		require.Equal(t, true, res.Result)
+	}
+
+	// the single requests aren't split
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"eth_echo","params":["a"]}`))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	require.Contains(t, w.Body.String(), `"result":"a"`)
+}
+
+func TestBatchHandlerResponseMaxSizeWorkers(t *testing.T) {
+	handler, service := newBatchTestHandler(t, BatchConfig{ResponseMaxSize: 100, Workers: 1})
+	value := strings.Repeat("x", 100)
+
+	reqs := make([]string, 10)
+	for i := range reqs {
+		reqs[i] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"eth_echo","params":["%s"]}`, i+1, value)
+	}
+	_, responses := postBatch(t, handler, "["+strings.Join(reqs, ",")+"]")
+
+	// the read-only requests stop being dispatched once the max size is
+	// exceeded, only the ones already running are served
+	require.Len(t, responses, 10)
+	echoed := int(service.echoed.Load())
+	require.LessOrEqual(t, echoed, 3)
+	for i, res := range responses {
+		require.Equal(t, json.RawMessage(strconv.Itoa(i+1)), res.ID)
+		if res.Error == nil {
+			require.Equal(t, value, res.Result)
+			continue
+		}
+		require.Equal(t, ErrCodeResponseTooLarge, res.Error.Code)
+	}
+	require.NotNil(t, responses[9].Error)
+}
+
+func TestIsReadOnly(t *testing.T) {
+	testCases := []struct {
+		method      string
+		expReadOnly bool
+	}{
+		{"eth_getBalance", true},
+		{"eth_call", true},
+		{"debug_traceTransaction", true},
+		{"eth_sendRawTransaction", false},
+		{"eth_signTypedData", false},
+		{"eth_getFilterChanges", false},
+		{"eth_newFilter", false},
+		{"personal_unlockAccount", false},
+		{"debug_startCPUProfile", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.method, func(t *testing.T) {
+			require.Equal(t, tc.expReadOnly, isReadOnly(tc.method))
+		})
+	}
+}
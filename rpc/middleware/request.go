@@ -0,0 +1,127 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+// Package middleware implements the HTTP middlewares of the JSON-RPC server.
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	// ErrCodeMethodNotFound is the JSON-RPC error code of the requests calling
+	// a method which isn't available.
+	ErrCodeMethodNotFound = -32601
+	// ErrCodeUnauthorized is the error code of the requests sent with invalid
+	// credentials.
+	ErrCodeUnauthorized = -32001
+
+	// maxRequestContentLength is the max size of the body read to find the
+	// requested methods, same as the go-ethereum HTTP server.
+	maxRequestContentLength = 1024 * 1024 * 5
+)
+
+// Request is the part of a JSON-RPC request the middlewares need.
+type Request struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+type errorResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   errorObject     `json:"error"`
+}
+
+type errorObject struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// ReadRequests decodes the single or batch JSON-RPC request of the body and
+// restores the body for the next handler. It returns true if it's a batch, the
+// requests are empty if the body isn't valid.
+func ReadRequests(r *http.Request) ([]Request, bool, error) {
+	if r.Body == nil {
+		return nil, false, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength))
+	if err != nil {
+		return nil, false, err
+	}
+	r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), r.Body))
+
+	body = bytes.TrimLeft(body, " \t\r\n")
+	if len(body) > 0 && body[0] == '[' {
+		var reqs []Request
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			return nil, true, nil
+		}
+		return reqs, true, nil
+	}
+
+	var req Request
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, false, nil
+	}
+	return []Request{req}, false, nil
+}
+
+// WriteErrors replies to the requests with the given JSON-RPC error, a single
+// error is written if the requests are empty.
+func WriteErrors(w http.ResponseWriter, status int, reqs []Request, batch bool, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(append(EncodeErrors(reqs, batch, code, msg), '\n'))
+}
+
+// EncodeErrors returns the message replying to the requests with the given
+// JSON-RPC error, a single error is returned if the requests are empty.
+func EncodeErrors(reqs []Request, batch bool, code int, msg string) []byte {
+	if len(reqs) == 0 {
+		reqs = []Request{{}}
+	}
+
+	responses := make([]errorResponse, len(reqs))
+	for i, req := range reqs {
+		responses[i] = errorResponse{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error:   errorObject{Code: code, Message: msg},
+		}
+	}
+
+	var bz []byte
+	if batch {
+		bz, _ = json.Marshal(responses)
+	} else {
+		bz, _ = json.Marshal(responses[0])
+	}
+	return bz
+}
+
+// MatchMethod returns true if the method matches the pattern, which is either
+// a method name or a prefix ending with a `*` wildcard, eg. `debug_*`.
+func MatchMethod(pattern, method string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(method, prefix)
+	}
+	return pattern == method
+}
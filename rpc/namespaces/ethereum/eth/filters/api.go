@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	rpcmetrics "github.com/evmos/ethermint/rpc/metrics"
 	"github.com/evmos/ethermint/rpc/types"
 
 	"github.com/cometbft/cometbft/libs/log"
@@ -35,6 +36,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -61,6 +63,7 @@ type Backend interface {
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
+	LogIndexer() ethermint.EVMLogIndexer
 
 	RPCFilterCap() int32
 	RPCLogsCap() int32
@@ -206,12 +209,23 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 
 // NewPendingTransactions creates a subscription that is triggered each time a transaction
 // enters the transaction pool and was signed from one of the transactions this nodes manages.
-func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+// The transaction hash is sent by default, the criteria can request the full transaction
+// and filter the transactions by sender and recipient.
+func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context, crit *PendingTxCriteria) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
 		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
 	}
 
+	if crit == nil {
+		crit = &PendingTxCriteria{}
+	}
+
+	chainID, err := crit.ChainID(api.clientCtx.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
 	rpcSub := notifier.CreateSubscription()
 
 	ctx, cancelFn := context.WithTimeout(context.Background(), deadline)
@@ -226,6 +240,7 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 
 	go func(txsCh <-chan coretypes.ResultEvent) {
 		defer cancelSubs()
+		defer rpcmetrics.TrackSubscription("newPendingTransactions")()
 
 		for {
 			select {
@@ -251,8 +266,17 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 
 				for _, msg := range tx.GetMsgs() {
 					ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
-					if ok {
-						_ = notifier.Notify(rpcSub.ID, ethTx.AsTransaction().Hash())
+					if !ok {
+						continue
+					}
+
+					result, matched, err := crit.Result(ethTx, chainID)
+					if err != nil {
+						api.logger.Debug("failed to build pending tx notification", "error", err.Error())
+						continue
+					}
+					if matched {
+						_ = notifier.Notify(rpcSub.ID, result)
 					}
 				}
 			case <-rpcSub.Err():
@@ -341,6 +365,7 @@ func (api *PublicFilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, er
 
 	go func(headersCh <-chan coretypes.ResultEvent) {
 		defer cancelSubs()
+		defer rpcmetrics.TrackSubscription("newHeads")()
 
 		for {
 			select {
@@ -391,6 +416,7 @@ func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteri
 
 	go func(logsCh <-chan coretypes.ResultEvent) {
 		defer cancelSubs()
+		defer rpcmetrics.TrackSubscription("logs")()
 
 		for {
 			select {
@@ -20,9 +20,11 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/evmos/ethermint/rpc/backend"
 	"github.com/evmos/ethermint/rpc/types"
+	ethermint "github.com/evmos/ethermint/types"
 
 	"github.com/cometbft/cometbft/libs/log"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -155,7 +157,23 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*e
 		f.criteria.ToBlock = big.NewInt(1)
 	}
 
-	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
+	// the blocks covered by the log index are not scanned, so the block range cap only
+	// applies to the blocks missing from the log index and past its end
+	logIndexer, indexedTo, err := f.logIndex(f.criteria.FromBlock.Int64())
+	if err != nil {
+		return nil, err
+	}
+	scanFrom := f.criteria.FromBlock.Int64()
+	var unindexed []int64
+	if logIndexer != nil {
+		unindexed, err = logIndexer.LogUnindexedBlocks(scanFrom, min(f.criteria.ToBlock.Int64(), indexedTo))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to fetch blocks missing from log index")
+		}
+		scanFrom = indexedTo + 1
+	}
+
+	if f.criteria.ToBlock.Int64()-scanFrom+int64(len(unindexed)) > blockLimit {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
 
@@ -169,7 +187,23 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*e
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
+	heights := []int64{}
+	if logIndexer != nil {
+		indexed, err := logIndexer.GetLogBlocks(f.criteria.Addresses, f.topics0(), from, min(to, indexedTo))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to fetch blocks from log index")
+		}
+		heights = append(heights, indexed...)
+		heights = append(heights, unindexed...)
+		sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+		from = scanFrom
+	}
 	for height := from; height <= to; height++ {
+		heights = append(heights, height)
+	}
+
+	for _, height := range heights {
+		height := height
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
 			f.logger.Debug("failed to fetch block result from Tendermint", "height", height, "error", err.Error())
@@ -195,6 +229,36 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*e
 	return logs, nil
 }
 
+// logIndex returns the log indexer and the last block it covers if it can be used to
+// find the blocks matching the filter criteria from the given height, which requires
+// the criteria to have an address or first topic and the log index to cover that
+// height. It returns a nil indexer otherwise. The blocks within that range may still
+// be missing from the log index if it was disabled for a while.
+func (f *Filter) logIndex(from int64) (ethermint.EVMLogIndexer, int64, error) {
+	logIndexer := f.backend.LogIndexer()
+	if logIndexer == nil || (len(f.criteria.Addresses) == 0 && len(f.topics0()) == 0) {
+		return nil, 0, nil
+	}
+
+	first, last, err := logIndexer.LogIndexedRange()
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to fetch log index range")
+	}
+	if first == -1 || from < first || from > last {
+		return nil, 0, nil
+	}
+	return logIndexer, last, nil
+}
+
+// topics0 returns the first topics of the filter criteria, returns nil if any first
+// topic matches.
+func (f *Filter) topics0() []common.Hash {
+	if len(f.criteria.Topics) == 0 {
+		return nil
+	}
+	return f.criteria.Topics[0]
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
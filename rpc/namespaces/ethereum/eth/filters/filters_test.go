@@ -0,0 +1,176 @@
+package filters
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc/types"
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// logsBackend serves the blocks of a chain whose logs are all emitted by one address
+type logsBackend struct {
+	Backend
+
+	head       int64
+	logBlocks  map[int64]bool
+	logIndexer *logIndexer
+	scanned    []int64
+}
+
+func (b *logsBackend) HeaderByNumber(types.BlockNumber) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(b.head)}, nil
+}
+
+func (b *logsBackend) TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error) {
+	b.scanned = append(b.scanned, *height)
+	res := &coretypes.ResultBlockResults{Height: *height}
+	if b.logBlocks[*height] {
+		bz, err := json.Marshal(&evmtypes.Log{Address: logsAddress.Hex(), BlockNumber: uint64(*height)})
+		if err != nil {
+			return nil, err
+		}
+		res.TxsResults = []*abci.ResponseDeliverTx{{Events: []abci.Event{{
+			Type:       evmtypes.EventTypeTxLog,
+			Attributes: []abci.EventAttribute{{Key: evmtypes.AttributeKeyTxLog, Value: string(bz)}},
+		}}}}
+	}
+	return res, nil
+}
+
+func (b *logsBackend) BlockBloom(*coretypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	var bloom ethtypes.Bloom
+	bloom.Add(logsAddress.Bytes())
+	return bloom, nil
+}
+
+func (b *logsBackend) LogIndexer() ethermint.EVMLogIndexer {
+	if b.logIndexer == nil {
+		return nil
+	}
+	return b.logIndexer
+}
+
+// logIndexer indexes the log blocks of its backend at the covered heights
+type logIndexer struct {
+	backend *logsBackend
+	covered map[int64]bool
+}
+
+func (idx *logIndexer) LogIndexedRange() (int64, int64, error) {
+	first, last := int64(-1), int64(-1)
+	for height := range idx.covered {
+		if first == -1 || height < first {
+			first = height
+		}
+		if height > last {
+			last = height
+		}
+	}
+	return first, last, nil
+}
+
+func (idx *logIndexer) LogUnindexedBlocks(from, to int64) ([]int64, error) {
+	heights := []int64{}
+	for height := from; height <= to; height++ {
+		if !idx.covered[height] {
+			heights = append(heights, height)
+		}
+	}
+	return heights, nil
+}
+
+func (idx *logIndexer) GetLogBlocks(_ []common.Address, _ []common.Hash, from, to int64) ([]int64, error) {
+	heights := []int64{}
+	for height := from; height <= to; height++ {
+		if idx.covered[height] && idx.backend.logBlocks[height] {
+			heights = append(heights, height)
+		}
+	}
+	return heights, nil
+}
+
+var logsAddress = common.BigToAddress(big.NewInt(1))
+
+func TestFilterLogsLogIndex(t *testing.T) {
+	testCases := []struct {
+		name       string
+		covered    []int64
+		from, to   int64
+		blockLimit int64
+		expBlocks  []uint64
+		expScanned []int64
+		expErr     bool
+	}{
+		{
+			"no log index",
+			nil, 1, 10, 100,
+			[]uint64{2, 4, 6, 9}, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, false,
+		},
+		{
+			"log index covers the range",
+			[]int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 1, 10, 100,
+			[]uint64{2, 4, 6, 9}, []int64{2, 4, 6, 9}, false,
+		},
+		{
+			"blocks past the log index are scanned",
+			[]int64{1, 2, 3, 4, 5}, 1, 10, 100,
+			[]uint64{2, 4, 6, 9}, []int64{2, 4, 6, 7, 8, 9, 10}, false,
+		},
+		{
+			"blocks missing from the log index are scanned",
+			[]int64{1, 2, 3, 7, 8, 9, 10}, 1, 10, 100,
+			[]uint64{2, 4, 6, 9}, []int64{2, 4, 5, 6, 9}, false,
+		},
+		{
+			"range starting before the log index",
+			[]int64{5, 6, 7, 8, 9, 10}, 1, 10, 100,
+			[]uint64{2, 4, 6, 9}, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, false,
+		},
+		{
+			"block limit counts the blocks missing from the log index",
+			[]int64{1, 2, 3, 7, 8, 9, 10}, 1, 10, 1,
+			nil, nil, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := &logsBackend{
+				head:      10,
+				logBlocks: map[int64]bool{2: true, 4: true, 6: true, 9: true},
+			}
+			if tc.covered != nil {
+				backend.logIndexer = &logIndexer{backend: backend, covered: map[int64]bool{}}
+				for _, height := range tc.covered {
+					backend.logIndexer.covered[height] = true
+				}
+			}
+
+			filter := NewRangeFilter(log.NewNopLogger(), backend, tc.from, tc.to, []common.Address{logsAddress}, nil)
+			logs, err := filter.Logs(context.Background(), 100, tc.blockLimit)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			blocks := make([]uint64, len(logs))
+			for i, log := range logs {
+				blocks[i] = log.BlockNumber
+			}
+			require.Equal(t, tc.expBlocks, blocks)
+			require.Equal(t, tc.expScanned, backend.scanned)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package filters
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/rpc/types"
+	ethermint "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// PendingTxCriteria are the options of the newPendingTransactions subscription.
+// It's decoded either from the go-ethereum `fullTx` boolean or from an object
+// which can also filter the transactions by sender and recipient. An empty
+// address list matches any address.
+type PendingTxCriteria struct {
+	FullTx bool             `json:"fullTx"`
+	From   []common.Address `json:"from"`
+	To     []common.Address `json:"to"`
+}
+
+// UnmarshalJSON decodes the criteria from a boolean or an object.
+func (c *PendingTxCriteria) UnmarshalJSON(bz []byte) error {
+	var fullTx bool
+	if err := json.Unmarshal(bz, &fullTx); err == nil {
+		*c = PendingTxCriteria{FullTx: fullTx}
+		return nil
+	}
+
+	// alias to avoid recursing into this method
+	type criteria PendingTxCriteria
+	var crit criteria
+	if err := json.Unmarshal(bz, &crit); err != nil {
+		return errors.New("invalid pending transactions criteria, expected a boolean or an object")
+	}
+	*c = PendingTxCriteria(crit)
+	return nil
+}
+
+// ChainID returns the chain ID to pass to Result, parsed from the given
+// Cosmos chain ID. The chain ID is only used by the full transactions, so it's
+// nil if FullTx isn't set.
+func (c PendingTxCriteria) ChainID(cosmosChainID string) (*big.Int, error) {
+	if !c.FullTx {
+		return nil, nil
+	}
+	return ethermint.ParseChainID(cosmosChainID)
+}
+
+// Result returns the notification of the pending transaction: its RPC
+// representation if FullTx is set, its hash otherwise. It returns false if the
+// transaction doesn't match the sender and recipient filters. The chain ID is
+// only used by the RPC representation, it may be nil if FullTx isn't set.
+func (c PendingTxCriteria) Result(msg *evmtypes.MsgEthereumTx, chainID *big.Int) (interface{}, bool, error) {
+	tx := msg.AsTransaction()
+
+	if len(c.From) > 0 {
+		// the sender is recovered with the chain ID the tx is signed for
+		from, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			return nil, false, err
+		}
+		if !includes(c.From, from) {
+			return nil, false, nil
+		}
+	}
+	if len(c.To) > 0 && (tx.To() == nil || !includes(c.To, *tx.To())) {
+		return nil, false, nil
+	}
+
+	if !c.FullTx {
+		return tx.Hash(), true, nil
+	}
+	rpcTx, err := types.NewRPCTransaction(tx, common.Hash{}, 0, 0, nil, chainID)
+	if err != nil {
+		return nil, false, err
+	}
+	return rpcTx, true, nil
+}
@@ -0,0 +1,121 @@
+package filters
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/rpc/types"
+	"github.com/evmos/ethermint/tests"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func TestPendingTxCriteriaUnmarshalJSON(t *testing.T) {
+	from := common.BigToAddress(big.NewInt(1))
+	to := common.BigToAddress(big.NewInt(2))
+
+	testCases := []struct {
+		name    string
+		input   string
+		expCrit PendingTxCriteria
+		expErr  bool
+	}{
+		{"hashes", `false`, PendingTxCriteria{}, false},
+		{"full txs", `true`, PendingTxCriteria{FullTx: true}, false},
+		{"empty object", `{}`, PendingTxCriteria{}, false},
+		{
+			"object",
+			`{"fullTx":true,"from":["` + from.Hex() + `"],"to":["` + to.Hex() + `"]}`,
+			PendingTxCriteria{FullTx: true, From: []common.Address{from}, To: []common.Address{to}},
+			false,
+		},
+		{"invalid address", `{"from":["0x1"]}`, PendingTxCriteria{}, true},
+		{"invalid type", `"fullTx"`, PendingTxCriteria{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var crit PendingTxCriteria
+			err := json.Unmarshal([]byte(tc.input), &crit)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expCrit, crit)
+		})
+	}
+}
+
+func TestPendingTxCriteriaChainID(t *testing.T) {
+	// the chain ID is only parsed for the full txs
+	chainID, err := PendingTxCriteria{}.ChainID("invalid")
+	require.NoError(t, err)
+	require.Nil(t, chainID)
+
+	chainID, err = PendingTxCriteria{FullTx: true}.ChainID("ethermint_9000-1")
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(9000), chainID)
+
+	_, err = PendingTxCriteria{FullTx: true}.ChainID("invalid")
+	require.Error(t, err)
+}
+
+func TestPendingTxCriteriaResult(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	to := common.BigToAddress(big.NewInt(2))
+	other := common.BigToAddress(big.NewInt(3))
+	chainID := big.NewInt(9000)
+
+	msg := evmtypes.NewTx(chainID, 0, &to, big.NewInt(1), 21000, big.NewInt(1), nil, nil, nil, nil)
+	msg.From = from.Hex()
+	require.NoError(t, msg.Sign(ethtypes.LatestSignerForChainID(chainID), tests.NewSigner(priv)))
+	tx := msg.AsTransaction()
+	rpcTx, err := types.NewRPCTransaction(tx, common.Hash{}, 0, 0, nil, chainID)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		crit       PendingTxCriteria
+		chainID    *big.Int
+		expResult  interface{}
+		expMatched bool
+	}{
+		{"hash", PendingTxCriteria{}, nil, tx.Hash(), true},
+		{"full tx", PendingTxCriteria{FullTx: true}, chainID, rpcTx, true},
+		{"sender", PendingTxCriteria{From: []common.Address{other, from}}, nil, tx.Hash(), true},
+		{"other sender", PendingTxCriteria{From: []common.Address{other}}, nil, nil, false},
+		{"recipient", PendingTxCriteria{To: []common.Address{to}}, nil, tx.Hash(), true},
+		{"other recipient", PendingTxCriteria{To: []common.Address{other}}, nil, nil, false},
+		{
+			"full tx of sender and recipient",
+			PendingTxCriteria{FullTx: true, From: []common.Address{from}, To: []common.Address{to}},
+			chainID, rpcTx, true,
+		},
+		{"sender and other recipient", PendingTxCriteria{From: []common.Address{from}, To: []common.Address{other}}, nil, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, matched, err := tc.crit.Result(msg, tc.chainID)
+			require.NoError(t, err)
+			require.Equal(t, tc.expMatched, matched)
+			require.Equal(t, tc.expResult, result)
+		})
+	}
+
+	// contract creations don't match the recipient filter
+	create := evmtypes.NewTx(chainID, 0, nil, big.NewInt(0), 53000, big.NewInt(1), nil, nil, []byte{0x00}, nil)
+	create.From = from.Hex()
+	require.NoError(t, create.Sign(ethtypes.LatestSignerForChainID(chainID), tests.NewSigner(priv)))
+	_, matched, err := PendingTxCriteria{To: []common.Address{to}}.Result(create, nil)
+	require.NoError(t, err)
+	require.False(t, matched)
+}
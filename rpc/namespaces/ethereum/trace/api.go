@@ -0,0 +1,84 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package trace
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/rpc/backend"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// PublicAPI is the OpenEthereum (Parity) style `trace_` API. The traces are the
+// call frames of the `callTracer` flattened into a list.
+type PublicAPI struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewPublicAPI creates an instance of the trace API.
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the traces of all the transactions of the block.
+func (api *PublicAPI) Block(blockNum rpctypes.BlockNumber) ([]*rpctypes.ParityTrace, error) {
+	api.logger.Debug("trace_block", "number", blockNum)
+	return api.backend.ParityTraceBlock(blockNum)
+}
+
+// Transaction returns the traces of the transaction.
+func (api *PublicAPI) Transaction(hash common.Hash) ([]*rpctypes.ParityTrace, error) {
+	api.logger.Debug("trace_transaction", "hash", hash)
+	return api.backend.ParityTraceTransaction(hash)
+}
+
+// Filter returns the traces of the block range matching the from and to
+// addresses of the filter.
+func (api *PublicAPI) Filter(args rpctypes.TraceFilterArgs) ([]*rpctypes.ParityTrace, error) {
+	api.logger.Debug("trace_filter", "args", args)
+	return api.backend.ParityTraceFilter(args)
+}
+
+// ReplayBlockTransactions replays all the transactions of the block and returns
+// the requested trace types of each of them. Only the `trace` type is supported.
+func (api *PublicAPI) ReplayBlockTransactions(blockNum rpctypes.BlockNumber, traceTypes []string) ([]*rpctypes.ParityTraceResults, error) {
+	api.logger.Debug("trace_replayBlockTransactions", "number", blockNum, "traceTypes", traceTypes)
+	return api.backend.ParityTraceReplayBlockTransactions(blockNum, traceTypes)
+}
+
+// Call executes the call on top of the state of the given block, the latest one
+// by default, and returns the requested trace types. Only the `trace` type is
+// supported.
+func (api *PublicAPI) Call(
+	args evmtypes.TransactionArgs,
+	traceTypes []string,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (*rpctypes.ParityTraceResults, error) {
+	api.logger.Debug("trace_call", "args", args.String(), "traceTypes", traceTypes)
+
+	if blockNrOrHash == nil {
+		latest := rpctypes.EthLatestBlockNumber
+		blockNrOrHash = &rpctypes.BlockNumberOrHash{BlockNumber: &latest}
+	}
+	return api.backend.ParityTraceCall(args, traceTypes, *blockNrOrHash)
+}
@@ -16,51 +16,137 @@
 package txpool
 
 import (
+	"fmt"
+
 	"github.com/cometbft/cometbft/libs/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/evmos/ethermint/rpc/backend"
 	"github.com/evmos/ethermint/rpc/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/evmos/ethermint/issues/124
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.EVMBackend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
 // Content returns the transactions contained within the transaction pool
 func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_content")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]*types.RPCTransaction{
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+	for account, txs := range pending {
+		content["pending"][account.Hex()] = formatTxs(txs)
+	}
+	for account, txs := range queued {
+		content["queued"][account.Hex()] = formatTxs(txs)
+	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool
+// that were sent by the given address.
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address.Hex())
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*types.RPCTransaction{
+		"pending": formatTxs(pending[address]),
+		"queued":  formatTxs(queued[address]),
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+	for account, txs := range pending {
+		content["pending"][account.Hex()] = inspectTxs(txs)
+	}
+	for account, txs := range queued {
+		content["queued"][account.Hex()] = inspectTxs(txs)
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+
+	pending, queued, err := api.backend.TxPoolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(countTxs(pending)),
+		"queued":  hexutil.Uint(countTxs(queued)),
+	}, nil
+}
+
+// formatTxs indexes the transactions of an account by their decimal nonce.
+func formatTxs(txs map[uint64]*types.RPCTransaction) map[string]*types.RPCTransaction {
+	result := make(map[string]*types.RPCTransaction, len(txs))
+	for nonce, tx := range txs {
+		result[fmt.Sprintf("%d", nonce)] = tx
+	}
+	return result
+}
+
+// inspectTxs summarizes the transactions of an account the same way geth does.
+func inspectTxs(txs map[uint64]*types.RPCTransaction) map[string]string {
+	result := make(map[string]string, len(txs))
+	for nonce, tx := range txs {
+		to := "contract creation"
+		if tx.To != nil {
+			to = tx.To.Hex()
+		}
+		result[fmt.Sprintf("%d", nonce)] = fmt.Sprintf(
+			"%s: %v wei + %v gas × %v wei",
+			to, tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt(),
+		)
+	}
+	return result
+}
+
+// countTxs returns the total number of transactions in the given set.
+func countTxs(txs backend.TxPoolTransactions) int {
+	count := 0
+	for _, accountTxs := range txs {
+		count += len(accountTxs)
 	}
+	return count
 }
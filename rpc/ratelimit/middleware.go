@@ -0,0 +1,65 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ratelimit
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/evmos/ethermint/rpc/middleware"
+)
+
+// Handler returns a middleware rate limiting the JSON-RPC requests. Each call
+// of a batch takes a token and the whole batch is rejected if one of them is
+// limited. The requests which aren't JSON-RPC ones, eg. the WebSocket
+// upgrades, only take a token from the bucket of the client, the messages of
+// the connections are limited by the WebSocket server.
+func (l *Limiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client, err := l.ClientFromRequest(r)
+		if err != nil {
+			middleware.WriteErrors(w, http.StatusUnauthorized, nil, false, middleware.ErrCodeUnauthorized, err.Error())
+			return
+		}
+
+		if r.Method != http.MethodPost {
+			if !l.Allow(client, "") {
+				middleware.WriteErrors(w, http.StatusTooManyRequests, nil, false, ErrCodeLimitExceeded, "rate limit exceeded")
+				return
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		reqs, batch, err := middleware.ReadRequests(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(reqs) == 0 {
+			// let the server reply to the invalid requests, they still count
+			reqs = []middleware.Request{{}}
+		}
+
+		for _, req := range reqs {
+			if !l.Allow(client, req.Method) {
+				middleware.WriteErrors(w, http.StatusTooManyRequests, reqs, batch, ErrCodeLimitExceeded, fmt.Sprintf("rate limit exceeded for %s", req.Method))
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
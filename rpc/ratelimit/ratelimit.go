@@ -0,0 +1,242 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package ratelimit
+
+import (
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evmos/ethermint/rpc/middleware"
+)
+
+const (
+	// ErrCodeLimitExceeded is the EIP-1474 error code of the requests rejected
+	// by the rate limiter.
+	ErrCodeLimitExceeded = -32005
+
+	// APIKeyHeader is the header of the API key of the requests.
+	APIKeyHeader = "X-API-Key"
+	// APIKeyQueryParam is the URL query parameter of the API key of the
+	// requests, for the clients which can't set headers (eg. browser WebSockets).
+	APIKeyQueryParam = "apikey"
+
+	// sweepInterval is the interval the full buckets are removed at.
+	sweepInterval = time.Minute
+)
+
+// ErrInvalidAPIKey is returned when a request is sent with an unknown API key.
+var ErrInvalidAPIKey = errors.New("invalid API key")
+
+// Config defines the rates, in requests per second, of the limiter.
+type Config struct {
+	// PerIP is the rate of the clients identified by their IP.
+	PerIP float64
+	// Burst is the number of requests a client can send at once.
+	Burst int
+	// Methods are the rates of the methods per client, by method name. A name
+	// ending with `*` matches all the methods starting with the prefix.
+	Methods map[string]float64
+	// APIKeys are the rates of the clients identified by their API key.
+	APIKeys map[string]float64
+}
+
+// Client is the sender of requests, identified by its API key if it has one
+// and by its IP otherwise.
+type Client struct {
+	IP     string
+	APIKey string
+	rate   float64
+}
+
+// id returns the key of the buckets of the client.
+func (c Client) id() string {
+	if c.APIKey != "" {
+		return "key:" + c.APIKey
+	}
+	return "ip:" + c.IP
+}
+
+// ForwardHeader returns the headers identifying the client in the requests
+// forwarded on its behalf to the server on the loopback interface.
+func (c Client) ForwardHeader() http.Header {
+	header := http.Header{}
+	header.Set("X-Forwarded-For", c.IP)
+	if c.APIKey != "" {
+		header.Set(APIKeyHeader, c.APIKey)
+	}
+	return header
+}
+
+// bucket is a token bucket refilled at the given rate up to the burst.
+type bucket struct {
+	tokens float64
+	rate   float64
+	burst  float64
+	last   time.Time
+}
+
+func newBucket(rate, burst float64, now time.Time) *bucket {
+	return &bucket{tokens: burst, rate: rate, burst: burst, last: now}
+}
+
+// refill adds the tokens accumulated since the last refill.
+func (b *bucket) refill(now time.Time) {
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+}
+
+// Limiter rate limits the requests with a token bucket per client and another
+// per client and limited method.
+type Limiter struct {
+	cfg Config
+	now func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// NewLimiter creates the rate limiter of the given config.
+func NewLimiter(cfg Config) *Limiter {
+	return &Limiter{
+		cfg:       cfg,
+		now:       time.Now,
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// ClientFromRequest returns the client sending the request. The IP of the
+// requests received on the loopback interface is read from the
+// X-Forwarded-For header if any, as set by a local reverse proxy or by the
+// WebSocket server forwarding the requests of its clients. The last entry of
+// the header is used since it's the one added by the proxy.
+func (l *Limiter) ClientFromRequest(r *http.Request) (Client, error) {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	if parsed := net.ParseIP(ip); parsed != nil && parsed.IsLoopback() {
+		if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+			entries := strings.Split(forwarded[len(forwarded)-1], ",")
+			if last := strings.TrimSpace(entries[len(entries)-1]); last != "" {
+				ip = last
+			}
+		}
+	}
+
+	apiKey := r.Header.Get(APIKeyHeader)
+	if apiKey == "" {
+		apiKey = r.URL.Query().Get(APIKeyQueryParam)
+	}
+	if apiKey == "" {
+		return Client{IP: ip, rate: l.cfg.PerIP}, nil
+	}
+
+	rate, ok := l.cfg.APIKeys[apiKey]
+	if !ok {
+		return Client{}, ErrInvalidAPIKey
+	}
+	return Client{IP: ip, APIKey: apiKey, rate: rate}, nil
+}
+
+// LocalClient returns the client of a connection which isn't received over
+// the network, eg. an IPC one, identified by the given name. It's limited at
+// the rate of the clients identified by their IP.
+func (l *Limiter) LocalClient(name string) Client {
+	return Client{IP: name, rate: l.cfg.PerIP}
+}
+
+// Allow takes a token from the bucket of the client and from the bucket of the
+// method if it's limited. It returns false, without taking any token, if one
+// of the buckets is empty.
+func (l *Limiter) Allow(client Client, method string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	buckets := []*bucket{l.bucket(client.id(), client.rate, float64(l.cfg.Burst), now)}
+	if pattern, rate, ok := l.methodLimit(method); ok {
+		// the method burst is a second worth of requests
+		buckets = append(buckets, l.bucket(client.id()+"|"+pattern, rate, math.Max(1, rate), now))
+	}
+
+	for _, b := range buckets {
+		b.refill(now)
+		if b.tokens < 1 {
+			return false
+		}
+	}
+	for _, b := range buckets {
+		b.tokens--
+	}
+	return true
+}
+
+// bucket returns the bucket of the given key, creating it if needed.
+func (l *Limiter) bucket(key string, rate, burst float64, now time.Time) *bucket {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = newBucket(rate, burst, now)
+		l.buckets[key] = b
+	}
+	return b
+}
+
+// methodLimit returns the limit matching the method, an exact match first and
+// then the longest wildcard prefix.
+func (l *Limiter) methodLimit(method string) (string, float64, bool) {
+	if method == "" {
+		return "", 0, false
+	}
+	if rate, ok := l.cfg.Methods[method]; ok {
+		return method, rate, true
+	}
+
+	var (
+		match string
+		rate  float64
+	)
+	for pattern, patternRate := range l.cfg.Methods {
+		if !strings.HasSuffix(pattern, "*") || !middleware.MatchMethod(pattern, method) || len(pattern) <= len(match) {
+			continue
+		}
+		match, rate = pattern, patternRate
+	}
+	return match, rate, match != ""
+}
+
+// sweep removes the buckets which are full, they are the same as new ones.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		b.refill(now)
+		if b.tokens >= b.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
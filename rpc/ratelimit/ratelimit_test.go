@@ -0,0 +1,167 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestLimiter returns a limiter with a clock advanced by the returned function.
+func newTestLimiter(cfg Config) (*Limiter, func(time.Duration)) {
+	now := time.Unix(0, 0)
+	l := NewLimiter(cfg)
+	l.now = func() time.Time { return now }
+	l.lastSweep = now
+	return l, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestLimiterAllow(t *testing.T) {
+	l, advance := newTestLimiter(Config{
+		PerIP: 2,
+		Burst: 3,
+		Methods: map[string]float64{
+			"eth_getLogs":  1,
+			"debug_*":      0.5,
+			"debug_trace*": 2,
+		},
+	})
+	client := Client{IP: "1.2.3.4", rate: 2}
+	other := Client{IP: "5.6.7.8", rate: 2}
+
+	// the burst is spent, then the bucket is refilled at the rate
+	for i := 0; i < 3; i++ {
+		require.True(t, l.Allow(client, "eth_chainId"))
+	}
+	require.False(t, l.Allow(client, "eth_chainId"))
+	require.True(t, l.Allow(other, "eth_chainId"))
+	advance(500 * time.Millisecond)
+	require.True(t, l.Allow(client, "eth_chainId"))
+	require.False(t, l.Allow(client, "eth_chainId"))
+
+	// the method bucket is checked in addition to the client one
+	advance(10 * time.Second)
+	require.True(t, l.Allow(client, "eth_getLogs"))
+	require.False(t, l.Allow(client, "eth_getLogs"))
+	require.True(t, l.Allow(client, "eth_chainId"))
+
+	// a rejected call doesn't take a token from the client bucket
+	advance(10 * time.Second)
+	require.True(t, l.Allow(client, "debug_cpuProfile"))
+	require.False(t, l.Allow(client, "debug_writeMemProfile"))
+	require.True(t, l.Allow(client, "eth_chainId"))
+	require.True(t, l.Allow(client, "eth_chainId"))
+
+	// the longest wildcard prefix matches
+	require.True(t, l.Allow(other, "debug_traceTransaction"))
+	require.True(t, l.Allow(other, "debug_traceTransaction"))
+	require.False(t, l.Allow(other, "debug_traceTransaction"))
+}
+
+func TestLimiterSweep(t *testing.T) {
+	l, advance := newTestLimiter(Config{PerIP: 1, Burst: 1})
+	client := Client{IP: "1.2.3.4", rate: 1}
+
+	require.True(t, l.Allow(client, "eth_chainId"))
+	require.Len(t, l.buckets, 1)
+	advance(sweepInterval)
+	require.True(t, l.Allow(Client{IP: "5.6.7.8", rate: 1}, "eth_chainId"))
+	require.Len(t, l.buckets, 1)
+}
+
+func TestClientFromRequest(t *testing.T) {
+	l := NewLimiter(Config{PerIP: 1, APIKeys: map[string]float64{"key": 10}})
+
+	testCases := []struct {
+		name       string
+		remoteAddr string
+		url        string
+		header     map[string]string
+		expClient  Client
+		expErr     error
+	}{
+		{"remote IP", "1.2.3.4:1234", "/", nil, Client{IP: "1.2.3.4", rate: 1}, nil},
+		{
+			"forwarded IP ignored from a remote address",
+			"1.2.3.4:1234", "/",
+			map[string]string{"X-Forwarded-For": "5.6.7.8"},
+			Client{IP: "1.2.3.4", rate: 1}, nil,
+		},
+		{
+			"last forwarded IP from the loopback",
+			"127.0.0.1:1234", "/",
+			map[string]string{"X-Forwarded-For": "9.9.9.9, 5.6.7.8"},
+			Client{IP: "5.6.7.8", rate: 1}, nil,
+		},
+		{
+			"API key header",
+			"1.2.3.4:1234", "/",
+			map[string]string{APIKeyHeader: "key"},
+			Client{IP: "1.2.3.4", APIKey: "key", rate: 10}, nil,
+		},
+		{"API key query parameter", "1.2.3.4:1234", "/?apikey=key", nil, Client{IP: "1.2.3.4", APIKey: "key", rate: 10}, nil},
+		{"unknown API key", "1.2.3.4:1234", "/?apikey=unknown", nil, Client{}, ErrInvalidAPIKey},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, tc.url, nil)
+			r.RemoteAddr = tc.remoteAddr
+			for key, value := range tc.header {
+				r.Header.Set(key, value)
+			}
+
+			client, err := l.ClientFromRequest(r)
+			require.ErrorIs(t, err, tc.expErr)
+			require.Equal(t, tc.expClient, client)
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	l, _ := newTestLimiter(Config{
+		PerIP:   100,
+		Burst:   100,
+		Methods: map[string]float64{"eth_getLogs": 1},
+	})
+	handler := l.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	post := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		return w
+	}
+
+	w := post(`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{}]}`)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "ok", w.Body.String())
+
+	w = post(`{"jsonrpc":"2.0","id":2,"method":"eth_getLogs","params":[{}]}`)
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	var res struct {
+		ID    json.RawMessage    `json:"id"`
+		Error struct{ Code int } `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+	require.Equal(t, json.RawMessage("2"), res.ID)
+	require.Equal(t, ErrCodeLimitExceeded, res.Error.Code)
+
+	// the whole batch is rejected
+	w = post(`[{"jsonrpc":"2.0","id":3,"method":"eth_chainId"},{"jsonrpc":"2.0","id":4,"method":"eth_getLogs"}]`)
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	var batch []struct {
+		ID json.RawMessage `json:"id"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &batch))
+	require.Len(t, batch, 2)
+	require.Equal(t, json.RawMessage("3"), batch[0].ID)
+	require.Equal(t, json.RawMessage("4"), batch[1].ID)
+
+	w = post(`[{"jsonrpc":"2.0","id":5,"method":"eth_chainId"}]`)
+	require.Equal(t, http.StatusOK, w.Code)
+}
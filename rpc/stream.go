@@ -0,0 +1,513 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/cometbft/cometbft/libs/log"
+	ethmetrics "github.com/ethereum/go-ethereum/metrics"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	rpcmetrics "github.com/evmos/ethermint/rpc/metrics"
+	"github.com/evmos/ethermint/rpc/middleware"
+	"github.com/evmos/ethermint/rpc/ratelimit"
+	"github.com/evmos/ethermint/server/config"
+)
+
+// ipcClientName identifies the IPC clients to the rate limiter
+const ipcClientName = "ipc"
+
+// StreamServer serves the IPC and the WebSocket on HTTP connections with the
+// JSON-RPC server, so they have the same methods as the HTTP requests. The
+// messages are checked like the HTTP ones before they're read by the server.
+type StreamServer struct {
+	rpcServer *ethrpc.Server
+	logger    log.Logger
+
+	// origins are the origins the WebSocket connections are accepted from
+	origins []string
+
+	// per-connection limits, 0 disables the limit
+	batchRequestLimit   int
+	maxSubscriptions    int
+	maxMessageSize      int64
+	slowConsumerTimeout time.Duration
+
+	// limiter rate limits the requests of the clients, nil if disabled
+	limiter *ratelimit.Limiter
+	// access restricts the methods the clients can call, nil if disabled
+	access *middleware.AccessControl
+}
+
+// NewStreamServer creates the stream server of the JSON-RPC server, the
+// limits are the ones of the WebSocket server.
+func NewStreamServer(
+	logger log.Logger,
+	rpcServer *ethrpc.Server,
+	cfg *config.Config,
+	limiter *ratelimit.Limiter,
+	access *middleware.AccessControl,
+) *StreamServer {
+	return &StreamServer{
+		rpcServer: rpcServer,
+		logger:    logger.With("api", "stream-server"),
+		origins:   cfg.JSONRPC.WSOrigins,
+
+		batchRequestLimit:   cfg.JSONRPC.BatchRequestLimit,
+		maxSubscriptions:    cfg.JSONRPC.WSMaxSubscriptions,
+		maxMessageSize:      cfg.JSONRPC.WSMaxMessageSize,
+		slowConsumerTimeout: cfg.JSONRPC.WSSlowConsumerTimeout,
+		limiter:             limiter,
+		access:              access,
+	}
+}
+
+// ServeIPC serves the connections of the IPC listener until it's closed. The
+// socket is only accessible by the user running the node, so its clients are
+// authenticated.
+func (s *StreamServer) ServeIPC(ln net.Listener) error {
+	return s.rpcServer.ServeListener(&ipcListener{Listener: ln, srv: s})
+}
+
+// ServeHTTP upgrades the request to a WebSocket connection served by the
+// JSON-RPC server.
+func (s *StreamServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	upgrader := websocket.Upgrader{
+		CheckOrigin: originChecker(s.origins),
+	}
+
+	stream := s.newStreamConn()
+	if s.limiter != nil {
+		var err error
+		if stream.client, err = s.limiter.ClientFromRequest(r); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	// the token is only checked on connection
+	if s.access != nil {
+		var err error
+		if stream.authenticated, err = s.access.Authenticate(r); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		s.logger.Debug("websocket upgrade failed", "error", err.Error())
+		return
+	}
+
+	if s.maxMessageSize > 0 {
+		conn.SetReadLimit(s.maxMessageSize)
+	}
+
+	c := &websocketStream{conn: conn, stream: stream}
+	s.rpcServer.ServeCodec(ethrpc.NewFuncCodec(conn, c.encode, c.decode), 0)
+}
+
+func (s *StreamServer) newStreamConn() *streamConn {
+	return &streamConn{
+		srv:           s,
+		calls:         make(map[string]streamCall),
+		subscriptions: make(map[string]bool),
+	}
+}
+
+// streamRequest is the part of a request the stream connections check.
+type streamRequest struct {
+	middleware.Request
+	Params []json.RawMessage `json:"params"`
+}
+
+// streamResponse is the part of a response the stream connections track.
+type streamResponse struct {
+	ID     json.RawMessage `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code int `json:"code"`
+	} `json:"error"`
+}
+
+// streamCall is a call waiting for its response.
+type streamCall struct {
+	method string
+	start  time.Time
+}
+
+// streamConn checks the requests of a connection before they're read by the
+// JSON-RPC server and tracks its responses, to count its subscriptions and
+// record the calls.
+type streamConn struct {
+	srv *StreamServer
+
+	// client is the sender of the requests, they are rate limited on its behalf
+	client ratelimit.Client
+	// authenticated is true if the client connected with a valid token
+	authenticated bool
+
+	mu sync.Mutex
+	// calls are the calls waiting for their response by request id
+	calls map[string]streamCall
+	// subscriptions are the ids of the active subscriptions
+	subscriptions map[string]bool
+}
+
+// check returns the message rejecting the requests of the message, nil if
+// they're allowed. The invalid messages are left to the JSON-RPC server.
+func (c *streamConn) check(msg []byte) []byte {
+	reqs, batch := parseStreamRequests(msg)
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	errReqs := make([]middleware.Request, len(reqs))
+	for i, req := range reqs {
+		errReqs[i] = req.Request
+	}
+
+	if batch && c.srv.batchRequestLimit > 0 && len(reqs) > c.srv.batchRequestLimit {
+		return middleware.EncodeErrors(errReqs, batch, middleware.ErrCodeInvalidRequest, fmt.Sprintf("batch too large, max %d requests", c.srv.batchRequestLimit))
+	}
+
+	for _, req := range reqs {
+		if c.srv.access != nil {
+			if code, err := c.srv.access.CheckMethod(req.Method, c.authenticated); err != nil {
+				return middleware.EncodeErrors(errReqs, batch, code, err.Error())
+			}
+		}
+		if c.srv.limiter != nil && !c.srv.limiter.Allow(c.client, req.Method) {
+			return middleware.EncodeErrors(errReqs, batch, ratelimit.ErrCodeLimitExceeded, fmt.Sprintf("rate limit exceeded for %s", req.Method))
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.srv.maxSubscriptions > 0 {
+		subscribing := 0
+		for _, req := range reqs {
+			if req.Method == "eth_subscribe" {
+				subscribing++
+			}
+		}
+		if subscribing > 0 {
+			for _, call := range c.calls {
+				if call.method == "eth_subscribe" {
+					subscribing++
+				}
+			}
+			if len(c.subscriptions)+subscribing > c.srv.maxSubscriptions {
+				wsSubscriptionsRejectedCounter.Inc(1)
+				return middleware.EncodeErrors(errReqs, batch, ratelimit.ErrCodeLimitExceeded, fmt.Sprintf("too many subscriptions, max %d per connection", c.srv.maxSubscriptions))
+			}
+		}
+	}
+
+	now := time.Now()
+	for _, req := range reqs {
+		if req.Method == "eth_unsubscribe" && len(req.Params) > 0 {
+			var id string
+			if err := json.Unmarshal(req.Params[0], &id); err == nil {
+				delete(c.subscriptions, id)
+			}
+		}
+		// the notifications have no response
+		if len(req.ID) > 0 && c.tracked(req.Method) {
+			c.calls[string(req.ID)] = streamCall{method: req.Method, start: now}
+		}
+	}
+	return nil
+}
+
+// tracked returns true if the response of the method is tracked.
+func (c *streamConn) tracked(method string) bool {
+	return ethmetrics.Enabled || (c.srv.maxSubscriptions > 0 && method == "eth_subscribe")
+}
+
+// onResponse records the calls replied by the message and the subscriptions
+// they created.
+func (c *streamConn) onResponse(msg []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.calls) == 0 {
+		return
+	}
+
+	for _, res := range parseStreamResponses(msg) {
+		call, ok := c.calls[string(res.ID)]
+		if !ok {
+			continue
+		}
+		delete(c.calls, string(res.ID))
+
+		code := 0
+		if res.Error != nil {
+			code = res.Error.Code
+		}
+		if ethmetrics.Enabled {
+			rpcmetrics.RecordCall(call.method, time.Since(call.start), code)
+		}
+
+		if call.method == "eth_subscribe" && res.Error == nil {
+			var id string
+			if err := json.Unmarshal(res.Result, &id); err == nil {
+				c.subscriptions[id] = true
+			}
+		}
+	}
+}
+
+// parseStreamRequests decodes the single or batch request of the message, the
+// requests are empty if it isn't valid.
+func parseStreamRequests(msg []byte) ([]streamRequest, bool) {
+	msg = bytes.TrimLeft(msg, " \t\r\n")
+	if len(msg) > 0 && msg[0] == '[' {
+		var reqs []streamRequest
+		if err := json.Unmarshal(msg, &reqs); err != nil {
+			return nil, true
+		}
+		return reqs, true
+	}
+
+	var req streamRequest
+	if err := json.Unmarshal(msg, &req); err != nil {
+		return nil, false
+	}
+	return []streamRequest{req}, false
+}
+
+// parseStreamResponses decodes the single or batch response of the message.
+func parseStreamResponses(msg []byte) []streamResponse {
+	msg = bytes.TrimLeft(msg, " \t\r\n")
+	if len(msg) > 0 && msg[0] == '[' {
+		var res []streamResponse
+		_ = json.Unmarshal(msg, &res)
+		return res
+	}
+
+	var res streamResponse
+	if err := json.Unmarshal(msg, &res); err != nil {
+		return nil
+	}
+	return []streamResponse{res}
+}
+
+// websocketStream is the transport of a WebSocket connection served by the
+// JSON-RPC server.
+type websocketStream struct {
+	conn   *websocket.Conn
+	stream *streamConn
+	// mu guards the writes of the replies and the rejections
+	mu sync.Mutex
+}
+
+// decode reads the next allowed message, the rejected ones are replied.
+func (c *websocketStream) decode(v interface{}) error {
+	for {
+		_, msg, err := c.conn.ReadMessage()
+		if err != nil {
+			if err == websocket.ErrReadLimit {
+				wsOversizedClientsDroppedCounter.Inc(1)
+			}
+			return err
+		}
+
+		if reply := c.stream.check(msg); reply != nil {
+			if err := c.write(reply); err != nil {
+				return err
+			}
+			continue
+		}
+		return json.Unmarshal(msg, v)
+	}
+}
+
+func (c *websocketStream) encode(v interface{}) error {
+	msg, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	c.stream.onResponse(msg)
+	return c.write(msg)
+}
+
+// write writes the message to the connection, the connection is dropped if
+// the slow consumer timeout is exceeded.
+func (c *websocketStream) write(msg []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if timeout := c.stream.srv.slowConsumerTimeout; timeout > 0 {
+		_ = c.conn.SetWriteDeadline(time.Now().Add(timeout))
+	}
+	err := c.conn.WriteMessage(websocket.TextMessage, msg)
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		wsSlowClientsDroppedCounter.Inc(1)
+		_ = c.conn.Close()
+		return errSlowConsumer
+	}
+	return err
+}
+
+// ipcListener accepts the IPC connections of the stream server.
+type ipcListener struct {
+	net.Listener
+	srv *StreamServer
+}
+
+func (l *ipcListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	stream := l.srv.newStreamConn()
+	stream.authenticated = true
+	if l.srv.limiter != nil {
+		// the IPC clients share a bucket, they can't be told apart
+		stream.client = l.srv.limiter.LocalClient(ipcClientName)
+	}
+	return newIPCConn(conn, stream), nil
+}
+
+// ipcConn is an IPC connection sending a stream of JSON values, like the
+// go-ethereum IPC server. Its reads only return the allowed messages.
+type ipcConn struct {
+	net.Conn
+	stream *streamConn
+	dec    *json.Decoder
+	limit  *readLimiter
+	// buf holds the allowed message being read
+	buf bytes.Buffer
+	// mu guards the writes of the replies and the rejections
+	mu sync.Mutex
+}
+
+// newIPCConn wraps the connection, the messages larger than the max message
+// size of the server are rejected if it isn't 0.
+func newIPCConn(conn net.Conn, stream *streamConn) *ipcConn {
+	c := &ipcConn{Conn: conn, stream: stream}
+	if max := stream.srv.maxMessageSize; max > 0 {
+		c.limit = &readLimiter{r: conn, max: max}
+		c.dec = json.NewDecoder(c.limit)
+	} else {
+		c.dec = json.NewDecoder(conn)
+	}
+	return c
+}
+
+// Read reads the allowed messages of the stream, the rejected ones are
+// replied. The connection is dropped if a message is larger than the max
+// message size.
+func (c *ipcConn) Read(p []byte) (int, error) {
+	for c.buf.Len() == 0 {
+		if err := c.readMessage(); err != nil {
+			return 0, err
+		}
+	}
+	return c.buf.Read(p)
+}
+
+func (c *ipcConn) readMessage() error {
+	if c.limit != nil {
+		c.limit.reset()
+	}
+
+	var msg json.RawMessage
+	err := c.dec.Decode(&msg)
+	if err == nil && c.limit != nil && int64(len(msg)) > c.limit.max {
+		err = websocket.ErrReadLimit
+	}
+	if err != nil {
+		if err == websocket.ErrReadLimit {
+			wsOversizedClientsDroppedCounter.Inc(1)
+			_ = c.Conn.Close()
+		}
+		return err
+	}
+
+	if reply := c.stream.check(msg); reply != nil {
+		_, err := c.write(append(reply, '\n'))
+		return err
+	}
+	c.buf.Write(msg)
+	c.buf.WriteByte('\n')
+	return nil
+}
+
+// Write writes a message of the JSON-RPC server, each one is written at once
+// by its encoder.
+func (c *ipcConn) Write(p []byte) (int, error) {
+	c.stream.onResponse(p)
+	return c.write(p)
+}
+
+// write writes the message to the connection, the connection is dropped if
+// the slow consumer timeout is exceeded.
+func (c *ipcConn) write(p []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if timeout := c.stream.srv.slowConsumerTimeout; timeout > 0 {
+		_ = c.Conn.SetWriteDeadline(time.Now().Add(timeout))
+	}
+	n, err := c.Conn.Write(p)
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		wsSlowClientsDroppedCounter.Inc(1)
+		_ = c.Conn.Close()
+		return n, errSlowConsumer
+	}
+	return n, err
+}
+
+// readLimiter bounds the bytes read since its last reset. The decoder reads
+// ahead of the value it decodes, so a message can be read with up to twice
+// the max bytes, the memory used by a connection is still bounded.
+type readLimiter struct {
+	r    io.Reader
+	max  int64
+	read int64
+}
+
+func (l *readLimiter) reset() {
+	l.read = 0
+}
+
+func (l *readLimiter) Read(p []byte) (int, error) {
+	if l.read >= l.max {
+		return 0, websocket.ErrReadLimit
+	}
+	if int64(len(p)) > l.max-l.read {
+		p = p[:l.max-l.read]
+	}
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	return n, err
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	rpcmetrics "github.com/evmos/ethermint/rpc/metrics"
+)
+
+// subscriptionsAPI serves the subscriptions of the WebSocket server to the
+// connections of the JSON-RPC server, IPC and WebSocket on the HTTP address, so
+// eth_subscribe behaves the same on every transport. Registered after the
+// filters API, its subscriptions replace the ones of the same name.
+type subscriptionsAPI struct {
+	api *pubSubAPI
+}
+
+// NewHeads notifies the headers of the new blocks.
+func (s *subscriptionsAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	return s.subscribe(ctx, "newHeads", nil)
+}
+
+// Logs notifies the logs matching the criteria, the logs from its fromBlock
+// are replayed first if it's in the past.
+func (s *subscriptionsAPI) Logs(ctx context.Context, crit *json.RawMessage) (*rpc.Subscription, error) {
+	return s.subscribe(ctx, "logs", crit)
+}
+
+// NewPendingTransactions notifies the transactions entering the mempool which
+// match the criteria.
+func (s *subscriptionsAPI) NewPendingTransactions(ctx context.Context, crit *json.RawMessage) (*rpc.Subscription, error) {
+	return s.subscribe(ctx, "newPendingTransactions", crit)
+}
+
+// Syncing notifies the changes of the catch-up state of the node.
+func (s *subscriptionsAPI) Syncing(ctx context.Context) (*rpc.Subscription, error) {
+	return s.subscribe(ctx, "syncing", nil)
+}
+
+// subscribe creates the subscription of the given type with the params of the
+// WebSocket server, it's cancelled on unsubscribe or when the connection is
+// closed.
+func (s *subscriptionsAPI) subscribe(ctx context.Context, typ string, crit *json.RawMessage) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	params := []interface{}{typ}
+	if crit != nil {
+		var extra interface{}
+		if err := json.Unmarshal(*crit, &extra); err != nil {
+			return nil, invalidSubscriptionParams(err)
+		}
+		params = append(params, extra)
+	}
+
+	sub := notifier.CreateSubscription()
+	// the notifier holds the notifications until the subscription id is sent
+	ready := make(chan struct{})
+	close(ready)
+	unsubFn, err := s.api.subscribe(sub.ID, params, ready, func(result interface{}) {
+		_ = notifier.Notify(sub.ID, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	untrack := rpcmetrics.TrackSubscription(typ)
+	go func() {
+		select {
+		case <-sub.Err():
+		case <-notifier.Closed():
+		}
+		unsubFn()
+		untrack()
+	}()
+	return sub, nil
+}
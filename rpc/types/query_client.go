@@ -25,6 +25,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 
+	rpcmetrics "github.com/evmos/ethermint/rpc/metrics"
+
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 )
@@ -39,12 +41,14 @@ type QueryClient struct {
 	FeeMarket feemarkettypes.QueryClient
 }
 
-// NewQueryClient creates a new gRPC query client
+// NewQueryClient creates a new gRPC query client, recording the latency of the
+// queries to the app.
 func NewQueryClient(clientCtx client.Context) *QueryClient {
+	conn := rpcmetrics.InstrumentClientConn(clientCtx)
 	return &QueryClient{
-		ServiceClient: tx.NewServiceClient(clientCtx),
-		QueryClient:   evmtypes.NewQueryClient(clientCtx),
-		FeeMarket:     feemarkettypes.NewQueryClient(clientCtx),
+		ServiceClient: tx.NewServiceClient(conn),
+		QueryClient:   evmtypes.NewQueryClient(conn),
+		FeeMarket:     feemarkettypes.NewQueryClient(conn),
 	}
 }
 
@@ -0,0 +1,246 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// Parity trace types
+const (
+	ParityTraceTypeCall    = "call"
+	ParityTraceTypeCreate  = "create"
+	ParityTraceTypeSuicide = "suicide"
+)
+
+// parityErrors maps the EVM execution errors to the messages used by the
+// OpenEthereum trace API.
+var parityErrors = []struct {
+	prefix string
+	msg    string
+}{
+	{vm.ErrExecutionReverted.Error(), "Reverted"},
+	{vm.ErrOutOfGas.Error(), "Out of gas"},
+	{vm.ErrCodeStoreOutOfGas.Error(), "Out of gas"},
+	{vm.ErrInvalidJump.Error(), "Bad jump destination"},
+	{"stack underflow", "Stack underflow"},
+	{"stack limit reached", "Out of stack"},
+	{vm.ErrDepth.Error(), "Out of stack"},
+	{"invalid opcode", "Bad instruction"},
+	{vm.ErrWriteProtection.Error(), "Mutable call in static context"},
+}
+
+// CallFrame is a call of the call tree returned by the `callTracer`.
+type CallFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	To      *common.Address `json:"to,omitempty"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []CallFrame     `json:"calls,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+}
+
+// ParityTraceAction is the action of a Parity trace. The fields set depend on the
+// trace type: call, create or suicide.
+type ParityTraceAction struct {
+	Address       *common.Address `json:"address,omitempty"`
+	Balance       *hexutil.Big    `json:"balance,omitempty"`
+	CallType      string          `json:"callType,omitempty"`
+	From          *common.Address `json:"from,omitempty"`
+	Gas           *hexutil.Uint64 `json:"gas,omitempty"`
+	Init          *hexutil.Bytes  `json:"init,omitempty"`
+	Input         *hexutil.Bytes  `json:"input,omitempty"`
+	RefundAddress *common.Address `json:"refundAddress,omitempty"`
+	To            *common.Address `json:"to,omitempty"`
+	Value         *hexutil.Big    `json:"value,omitempty"`
+}
+
+// ParityTraceResult is the result of a successful call or create Parity trace.
+type ParityTraceResult struct {
+	Address *common.Address `json:"address,omitempty"`
+	Code    *hexutil.Bytes  `json:"code,omitempty"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Output  *hexutil.Bytes  `json:"output,omitempty"`
+}
+
+// ParityTrace is a call frame flattened in the format of the OpenEthereum
+// `trace_` API. The block and transaction fields are omitted for the traces of
+// calls which aren't part of a block.
+type ParityTrace struct {
+	Action              ParityTraceAction  `json:"action"`
+	BlockHash           *common.Hash       `json:"blockHash,omitempty"`
+	BlockNumber         *uint64            `json:"blockNumber,omitempty"`
+	Error               string             `json:"error,omitempty"`
+	Result              *ParityTraceResult `json:"result"`
+	Subtraces           int                `json:"subtraces"`
+	TraceAddress        []int              `json:"traceAddress"`
+	TransactionHash     *common.Hash       `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64            `json:"transactionPosition,omitempty"`
+	Type                string             `json:"type"`
+}
+
+// ParityTraceResults is the result of the `trace_call` and
+// `trace_replayBlockTransactions` APIs. Only the `trace` trace type is
+// supported, the state diff and vm trace are always null.
+type ParityTraceResults struct {
+	Output          hexutil.Bytes  `json:"output"`
+	StateDiff       interface{}    `json:"stateDiff"`
+	Trace           []*ParityTrace `json:"trace"`
+	TransactionHash *common.Hash   `json:"transactionHash,omitempty"`
+	VMTrace         interface{}    `json:"vmTrace"`
+}
+
+// TraceFilterArgs are the arguments of the `trace_filter` API. A trace matches
+// if its sender is one of FromAddress and its recipient one of ToAddress, an
+// empty list matches any address.
+type TraceFilterArgs struct {
+	FromBlock   *BlockNumber     `json:"fromBlock"`
+	ToBlock     *BlockNumber     `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// Matches returns true if the trace matches the from and to addresses of the filter.
+func (args TraceFilterArgs) Matches(trace *ParityTrace) bool {
+	var from, to *common.Address
+	switch trace.Type {
+	case ParityTraceTypeSuicide:
+		from, to = trace.Action.Address, trace.Action.RefundAddress
+	case ParityTraceTypeCreate:
+		from = trace.Action.From
+		if trace.Result != nil {
+			to = trace.Result.Address
+		}
+	default:
+		from, to = trace.Action.From, trace.Action.To
+	}
+	return matchAddress(args.FromAddress, from) && matchAddress(args.ToAddress, to)
+}
+
+func matchAddress(addresses []common.Address, addr *common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	for _, a := range addresses {
+		if a == *addr {
+			return true
+		}
+	}
+	return false
+}
+
+// ParityTracesFromCallFrame flattens the call tree of a `callTracer` result
+// into Parity traces, in depth-first order. The position of every call in the
+// tree is recorded in its trace address.
+func ParityTracesFromCallFrame(frame *CallFrame) []*ParityTrace {
+	return appendParityTraces(nil, frame, []int{})
+}
+
+func appendParityTraces(traces []*ParityTrace, frame *CallFrame, traceAddress []int) []*ParityTrace {
+	trace := &ParityTrace{
+		Error:        parityError(frame.Error),
+		Subtraces:    len(frame.Calls),
+		TraceAddress: traceAddress,
+	}
+
+	value := frame.Value
+	if value == nil {
+		value = new(hexutil.Big)
+	}
+	from := frame.From
+	gas := frame.Gas
+	input := frame.Input
+	if input == nil {
+		input = hexutil.Bytes{}
+	}
+	output := frame.Output
+	if output == nil {
+		output = hexutil.Bytes{}
+	}
+
+	switch vm.StringToOp(frame.Type) {
+	case vm.CREATE, vm.CREATE2:
+		trace.Type = ParityTraceTypeCreate
+		trace.Action = ParityTraceAction{
+			From:  &from,
+			Gas:   &gas,
+			Init:  &input,
+			Value: value,
+		}
+		if frame.Error == "" {
+			trace.Result = &ParityTraceResult{
+				Address: frame.To,
+				Code:    &output,
+				GasUsed: frame.GasUsed,
+			}
+		}
+	case vm.SELFDESTRUCT:
+		trace.Type = ParityTraceTypeSuicide
+		trace.Action = ParityTraceAction{
+			Address:       &from,
+			Balance:       value,
+			RefundAddress: frame.To,
+		}
+	default:
+		trace.Type = ParityTraceTypeCall
+		trace.Action = ParityTraceAction{
+			CallType: strings.ToLower(frame.Type),
+			From:     &from,
+			Gas:      &gas,
+			Input:    &input,
+			To:       frame.To,
+			Value:    value,
+		}
+		if frame.Error == "" {
+			trace.Result = &ParityTraceResult{
+				GasUsed: frame.GasUsed,
+				Output:  &output,
+			}
+		}
+	}
+
+	traces = append(traces, trace)
+	for i := range frame.Calls {
+		childAddress := make([]int, len(traceAddress)+1)
+		copy(childAddress, traceAddress)
+		childAddress[len(traceAddress)] = i
+		traces = appendParityTraces(traces, &frame.Calls[i], childAddress)
+	}
+	return traces
+}
+
+// parityError returns the OpenEthereum message of an EVM execution error.
+func parityError(err string) string {
+	for _, e := range parityErrors {
+		if strings.HasPrefix(err, e.prefix) {
+			return e.msg
+		}
+	}
+	return err
+}
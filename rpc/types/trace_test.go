@@ -0,0 +1,185 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParityTracesFromCallFrame(t *testing.T) {
+	frameJSON := `{
+		"type": "CALL",
+		"from": "0x0000000000000000000000000000000000000001",
+		"to": "0x0000000000000000000000000000000000000002",
+		"gas": "0x5208",
+		"gasUsed": "0x5000",
+		"input": "0x12345678",
+		"output": "0x01",
+		"value": "0x1",
+		"calls": [
+			{
+				"type": "STATICCALL",
+				"from": "0x0000000000000000000000000000000000000002",
+				"to": "0x0000000000000000000000000000000000000003",
+				"gas": "0x100",
+				"gasUsed": "0x10",
+				"input": "0x",
+				"error": "execution reverted",
+				"calls": [
+					{
+						"type": "SELFDESTRUCT",
+						"from": "0x0000000000000000000000000000000000000003",
+						"to": "0x0000000000000000000000000000000000000004",
+						"gas": "0x0",
+						"gasUsed": "0x0",
+						"input": "0x",
+						"value": "0x5"
+					}
+				]
+			},
+			{
+				"type": "CREATE2",
+				"from": "0x0000000000000000000000000000000000000002",
+				"to": "0x0000000000000000000000000000000000000005",
+				"gas": "0x200",
+				"gasUsed": "0x20",
+				"input": "0x6000",
+				"output": "0x00",
+				"value": "0x0"
+			}
+		]
+	}`
+	var frame CallFrame
+	require.NoError(t, json.Unmarshal([]byte(frameJSON), &frame))
+
+	traces := ParityTracesFromCallFrame(&frame)
+	bz, err := json.Marshal(traces)
+	require.NoError(t, err)
+
+	expected := `[
+		{
+			"action": {
+				"callType": "call",
+				"from": "0x0000000000000000000000000000000000000001",
+				"gas": "0x5208",
+				"input": "0x12345678",
+				"to": "0x0000000000000000000000000000000000000002",
+				"value": "0x1"
+			},
+			"result": {"gasUsed": "0x5000", "output": "0x01"},
+			"subtraces": 2,
+			"traceAddress": [],
+			"type": "call"
+		},
+		{
+			"action": {
+				"callType": "staticcall",
+				"from": "0x0000000000000000000000000000000000000002",
+				"gas": "0x100",
+				"input": "0x",
+				"to": "0x0000000000000000000000000000000000000003",
+				"value": "0x0"
+			},
+			"error": "Reverted",
+			"result": null,
+			"subtraces": 1,
+			"traceAddress": [0],
+			"type": "call"
+		},
+		{
+			"action": {
+				"address": "0x0000000000000000000000000000000000000003",
+				"balance": "0x5",
+				"refundAddress": "0x0000000000000000000000000000000000000004"
+			},
+			"result": null,
+			"subtraces": 0,
+			"traceAddress": [0, 0],
+			"type": "suicide"
+		},
+		{
+			"action": {
+				"from": "0x0000000000000000000000000000000000000002",
+				"gas": "0x200",
+				"init": "0x6000",
+				"value": "0x0"
+			},
+			"result": {
+				"address": "0x0000000000000000000000000000000000000005",
+				"code": "0x00",
+				"gasUsed": "0x20"
+			},
+			"subtraces": 0,
+			"traceAddress": [1],
+			"type": "create"
+		}
+	]`
+	require.JSONEq(t, expected, string(bz))
+}
+
+func TestParityError(t *testing.T) {
+	testCases := []struct {
+		err    string
+		expErr string
+	}{
+		{"", ""},
+		{"execution reverted", "Reverted"},
+		{"out of gas", "Out of gas"},
+		{"invalid jump destination", "Bad jump destination"},
+		{"stack underflow (0 <=> 2)", "Stack underflow"},
+		{"invalid opcode: opcode 0xfe not defined", "Bad instruction"},
+		{"insufficient balance for transfer", "insufficient balance for transfer"},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.expErr, parityError(tc.err), tc.err)
+	}
+}
+
+func TestTraceFilterArgsMatches(t *testing.T) {
+	addr1 := common.HexToAddress("0x1")
+	addr2 := common.HexToAddress("0x2")
+	addr3 := common.HexToAddress("0x3")
+
+	call := &ParityTrace{
+		Type:   ParityTraceTypeCall,
+		Action: ParityTraceAction{From: &addr1, To: &addr2},
+	}
+	create := &ParityTrace{
+		Type:   ParityTraceTypeCreate,
+		Action: ParityTraceAction{From: &addr1},
+		Result: &ParityTraceResult{Address: &addr3},
+	}
+	failedCreate := &ParityTrace{
+		Type:   ParityTraceTypeCreate,
+		Action: ParityTraceAction{From: &addr1},
+	}
+	suicide := &ParityTrace{
+		Type:   ParityTraceTypeSuicide,
+		Action: ParityTraceAction{Address: &addr2, RefundAddress: &addr3},
+	}
+
+	testCases := []struct {
+		name     string
+		args     TraceFilterArgs
+		trace    *ParityTrace
+		expMatch bool
+	}{
+		{"empty filter", TraceFilterArgs{}, call, true},
+		{"call from", TraceFilterArgs{FromAddress: []common.Address{addr1}}, call, true},
+		{"call from mismatch", TraceFilterArgs{FromAddress: []common.Address{addr2}}, call, false},
+		{"call to", TraceFilterArgs{ToAddress: []common.Address{addr3, addr2}}, call, true},
+		{"call from and to", TraceFilterArgs{FromAddress: []common.Address{addr1}, ToAddress: []common.Address{addr2}}, call, true},
+		{"call from and to mismatch", TraceFilterArgs{FromAddress: []common.Address{addr1}, ToAddress: []common.Address{addr1}}, call, false},
+		{"create to created contract", TraceFilterArgs{ToAddress: []common.Address{addr3}}, create, true},
+		{"failed create to", TraceFilterArgs{ToAddress: []common.Address{addr3}}, failedCreate, false},
+		{"suicide from", TraceFilterArgs{FromAddress: []common.Address{addr2}}, suicide, true},
+		{"suicide to refund address", TraceFilterArgs{ToAddress: []common.Address{addr3}}, suicide, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expMatch, tc.args.Matches(tc.trace))
+		})
+	}
+}
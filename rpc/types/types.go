@@ -16,11 +16,15 @@
 package types
 
 import (
+	"encoding/json"
 	"math/big"
 
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -68,20 +72,49 @@ type RPCTransaction struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = evmtypes.OverrideAccount
+
+// BlockOverrides is a set of header fields to override during the execution
+// of a message call.
+type BlockOverrides = evmtypes.BlockOverrides
+
+// TraceCallConfig is the config for the debug_traceCall API. It extends the
+// TraceConfig with the state and block overrides applied before tracing.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+}
+
+// UnmarshalJSON decodes the trace call config, it's needed because the embedded
+// TraceConfig implements json.Unmarshaler which would skip the override fields.
+func (c *TraceCallConfig) UnmarshalJSON(bz []byte) error {
+	if err := c.TraceConfig.UnmarshalJSON(bz); err != nil {
+		return err
+	}
+
+	var overrides struct {
+		StateOverrides *StateOverride  `json:"stateOverrides"`
+		BlockOverrides *BlockOverrides `json:"blockOverrides"`
+	}
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return err
+	}
+	c.StateOverrides = overrides.StateOverrides
+	c.BlockOverrides = overrides.BlockOverrides
+	return nil
+}
+
+// AccessListResult returns an optional access list, the gas used if the
+// transaction is sent with it and the execution error if any.
+type AccessListResult struct {
+	AccessList *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
 }
 
 type FeeHistoryResult struct {
@@ -102,3 +135,62 @@ type OneFeeHistory struct {
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
 	GasUsedRatio         float64    // the ratio of gas used to the gas limit for each block
 }
+
+// SyncingResult is the notification of the syncing subscription sent when the
+// node starts catching up.
+type SyncingResult struct {
+	Syncing bool          `json:"syncing"`
+	Status  SyncingStatus `json:"status"`
+}
+
+// SyncingStatus is the progress of the catch-up. CometBFT doesn't expose the
+// height of the peers, so the highest block is the current one.
+type SyncingStatus struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+}
+
+// NewSyncingResult returns the syncing notification of a catch-up started at
+// the starting block which reached the current block.
+func NewSyncingResult(startingBlock, currentBlock int64) *SyncingResult {
+	return &SyncingResult{
+		Syncing: true,
+		Status: SyncingStatus{
+			StartingBlock: hexutil.Uint64(startingBlock),
+			CurrentBlock:  hexutil.Uint64(currentBlock),
+			HighestBlock:  hexutil.Uint64(currentBlock),
+		},
+	}
+}
+
+// SyncingTracker tracks the catch-up state of the node notified to a syncing
+// subscription.
+type SyncingTracker struct {
+	initialized bool
+	syncing     bool
+}
+
+// Update returns the notification of the given node sync info, and false if the
+// catch-up state notified so far didn't change. The first sync info is only
+// notified if the node is catching up. A catch-up which is in progress on the
+// first update is considered started at the earliest block of the node, the
+// other ones at the latest block before they are noticed.
+func (t *SyncingTracker) Update(info coretypes.SyncInfo) (interface{}, bool) {
+	initialized := t.initialized
+	t.initialized = true
+	if info.CatchingUp == t.syncing {
+		return nil, false
+	}
+
+	t.syncing = info.CatchingUp
+	if !t.syncing {
+		return false, true
+	}
+
+	startingBlock := info.LatestBlockHeight
+	if !initialized {
+		startingBlock = info.EarliestBlockHeight
+	}
+	return NewSyncingResult(startingBlock, info.LatestBlockHeight), true
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncingTracker(t *testing.T) {
+	syncInfo := func(catchingUp bool, latest int64) coretypes.SyncInfo {
+		return coretypes.SyncInfo{CatchingUp: catchingUp, EarliestBlockHeight: 5, LatestBlockHeight: latest}
+	}
+	type update struct {
+		info      coretypes.SyncInfo
+		expResult interface{}
+		expNotify bool
+	}
+
+	testCases := []struct {
+		name    string
+		updates []update
+	}{
+		{
+			"caught up",
+			[]update{
+				{syncInfo(false, 10), nil, false},
+				{syncInfo(false, 11), nil, false},
+			},
+		},
+		{
+			"catching up on subscription starts at the earliest block",
+			[]update{
+				{syncInfo(true, 10), NewSyncingResult(5, 10), true},
+				{syncInfo(true, 11), nil, false},
+				{syncInfo(false, 12), false, true},
+			},
+		},
+		{
+			"catch-up starts at the latest block",
+			[]update{
+				{syncInfo(false, 10), nil, false},
+				{syncInfo(true, 11), NewSyncingResult(11, 11), true},
+				{syncInfo(false, 20), false, true},
+				{syncInfo(true, 21), NewSyncingResult(21, 21), true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var tracker SyncingTracker
+			for _, u := range tc.updates {
+				result, ok := tracker.Update(u.info)
+				require.Equal(t, u.expNotify, ok)
+				require.Equal(t, u.expResult, result)
+			}
+		})
+	}
+
+	// the height of the peers isn't known, the highest block is the current one
+	bz, err := json.Marshal(NewSyncingResult(5, 10))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"syncing":true,"status":{"startingBlock":"0x5","currentBlock":"0xa","highestBlock":"0xa"}}`, string(bz))
+}
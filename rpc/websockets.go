@@ -24,7 +24,11 @@ import (
 	"math/big"
 	"net"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
@@ -34,22 +38,76 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/cometbft/cometbft/libs/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	tmtypes "github.com/cometbft/cometbft/types"
 
 	"github.com/evmos/ethermint/rpc/ethereum/pubsub"
+	rpcmetrics "github.com/evmos/ethermint/rpc/metrics"
+	"github.com/evmos/ethermint/rpc/middleware"
 	rpcfilters "github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
+	"github.com/evmos/ethermint/rpc/ratelimit"
 	"github.com/evmos/ethermint/rpc/types"
 	"github.com/evmos/ethermint/server/config"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
+var (
+	wsSubscriptionsRejectedCounter   = metrics.NewRegisteredCounter("rpc/ws/subscriptions/rejected", nil)
+	wsSlowClientsDroppedCounter      = metrics.NewRegisteredCounter("rpc/ws/clients/dropped/slow", nil)
+	wsOversizedClientsDroppedCounter = metrics.NewRegisteredCounter("rpc/ws/clients/dropped/oversized", nil)
+)
+
+const (
+	// errCodeInvalidParams is the JSON-RPC error code of the subscriptions with
+	// invalid parameters.
+	errCodeInvalidParams = -32602
+	// errCodeServer is the JSON-RPC error code of the subscriptions which failed
+	// on the server side, same as go-ethereum.
+	errCodeServer = -32000
+)
+
+// subscriptionError is an eth_subscribe error with its JSON-RPC error code.
+type subscriptionError struct {
+	code int
+	err  error
+}
+
+func (e *subscriptionError) Error() string { return e.err.Error() }
+
+// ErrorCode implements the go-ethereum rpc.Error interface.
+func (e *subscriptionError) ErrorCode() int { return e.code }
+
+// invalidSubscriptionParams returns the error of the subscription parameters
+// which can't be decoded.
+func invalidSubscriptionParams(err error) error {
+	return &subscriptionError{code: errCodeInvalidParams, err: err}
+}
+
+// subscriptionErrorCode returns the JSON-RPC error code of a subscription
+// error, the errors without a code failed on the server side.
+func subscriptionErrorCode(err error) int {
+	var subErr *subscriptionError
+	if errors.As(err, &subErr) {
+		return subErr.code
+	}
+	return errCodeServer
+}
+
+// errSlowConsumer is returned when a message can't be sent to a connection
+// which doesn't keep up with its notifications.
+var errSlowConsumer = errors.New("websocket connection is too slow, dropping peer")
+
 type WebsocketsServer interface {
 	Start()
+	// SubscriptionsAPI returns the eth_subscribe subscriptions of the server as
+	// an API of the JSON-RPC server
+	SubscriptionsAPI() rpc.API
 }
 
 type SubscriptionResponseJSON struct {
@@ -87,9 +145,31 @@ type websocketsServer struct {
 	keyFile  string
 	api      *pubSubAPI
 	logger   log.Logger
+
+	// origins are the origins the WebSocket connections are accepted from
+	origins []string
+
+	// per-connection limits, 0 disables the limit
+	maxSubscriptions    int
+	maxMessageSize      int64
+	maxOutboundQueue    int
+	slowConsumerTimeout time.Duration
+
+	// limiter rate limits the requests of the clients, nil if disabled
+	limiter *ratelimit.Limiter
+	// access restricts the methods the clients can call, nil if disabled
+	access *middleware.AccessControl
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	cfg *config.Config,
+	backend rpcfilters.Backend,
+	limiter *ratelimit.Limiter,
+	access *middleware.AccessControl,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address)
 
@@ -98,8 +178,27 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 		wsAddr:   cfg.JSONRPC.WsAddress,
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
+		api:      newPubSubAPI(clientCtx, logger, tmWSClient, backend),
 		logger:   logger,
+		origins:  cfg.JSONRPC.WSOrigins,
+
+		maxSubscriptions:    cfg.JSONRPC.WSMaxSubscriptions,
+		maxMessageSize:      cfg.JSONRPC.WSMaxMessageSize,
+		maxOutboundQueue:    cfg.JSONRPC.WSMaxOutboundQueue,
+		slowConsumerTimeout: cfg.JSONRPC.WSSlowConsumerTimeout,
+		limiter:             limiter,
+		access:              access,
+	}
+}
+
+// SubscriptionsAPI returns the subscriptions of the server as go-ethereum
+// subscriptions of the eth namespace.
+func (s *websocketsServer) SubscriptionsAPI() rpc.API {
+	return rpc.API{
+		Namespace: EthNamespace,
+		Version:   apiVersion,
+		Service:   &subscriptionsAPI{api: s.api},
+		Public:    true,
 	}
 }
 
@@ -128,9 +227,27 @@ func (s *websocketsServer) Start() {
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	upgrader := websocket.Upgrader{
-		CheckOrigin: func(_ *http.Request) bool {
-			return true
-		},
+		CheckOrigin: originChecker(s.origins),
+	}
+
+	var client ratelimit.Client
+	if s.limiter != nil {
+		var err error
+		if client, err = s.limiter.ClientFromRequest(r); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	// the token is only checked on connection, the requests forwarded to the
+	// JSON-RPC server are sent with a new one
+	var authenticated bool
+	if s.access != nil {
+		var err error
+		if authenticated, err = s.access.Authenticate(r); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
 	}
 
 	conn, err := upgrader.Upgrade(w, r, nil)
@@ -139,10 +256,37 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
-	})
+	if s.maxMessageSize > 0 {
+		conn.SetReadLimit(s.maxMessageSize)
+	}
+
+	wsConn := newWsConn(conn, s.maxOutboundQueue, s.slowConsumerTimeout)
+	wsConn.client = client
+	wsConn.authenticated = authenticated
+	s.readLoop(wsConn)
+}
+
+// originChecker returns the function returning true if the WebSocket
+// connection is accepted from the origin of the request. The requests without
+// origin aren't sent by browsers, they are always accepted.
+func originChecker(origins []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+
+		host := origin
+		if u, err := url.Parse(origin); err == nil && u.Host != "" {
+			host = u.Host
+		}
+		for _, allowed := range origins {
+			if allowed == "*" || strings.EqualFold(allowed, origin) || strings.EqualFold(allowed, host) {
+				return true
+			}
+		}
+		return false
+	}
 }
 
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
@@ -158,23 +302,129 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	_ = wsConn.WriteJSON(res)
 }
 
+// sendRequestErrResponse replies to the request rejected by the rate limiter,
+// the access control or the subscriptions limit.
+func (s *websocketsServer) sendRequestErrResponse(wsConn *wsConn, connID float64, code int, msg string) {
+	res := &ErrorResponseJSON{
+		Jsonrpc: "2.0",
+		Error: &ErrorMessageJSON{
+			Code:    big.NewInt(int64(code)),
+			Message: msg,
+		},
+		ID: big.NewInt(int64(connID)),
+	}
+
+	_ = wsConn.WriteJSON(res)
+}
+
+// wsConn is a websocket connection safe for concurrent writes. If the
+// outbound queue is enabled, the messages are queued and written by a
+// dedicated goroutine, the connection is dropped when the queue is full.
+// Otherwise the messages are written synchronously. In both cases the
+// connection is dropped when a write exceeds the write timeout.
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
+
+	writeTimeout time.Duration
+	send         chan interface{}
+	closed       chan struct{}
+	closeOnce    *sync.Once
+
+	// client is the sender of the requests, the requests forwarded to the
+	// JSON-RPC server are rate limited on its behalf
+	client ratelimit.Client
+	// authenticated is true if the client connected with a valid token
+	authenticated bool
+}
+
+// newWsConn wraps the connection and starts its write loop if the outbound
+// queue is enabled.
+func newWsConn(conn *websocket.Conn, maxOutboundQueue int, writeTimeout time.Duration) *wsConn {
+	w := &wsConn{
+		conn:         conn,
+		mux:          new(sync.Mutex),
+		writeTimeout: writeTimeout,
+		closed:       make(chan struct{}),
+		closeOnce:    new(sync.Once),
+	}
+
+	if maxOutboundQueue > 0 {
+		w.send = make(chan interface{}, maxOutboundQueue)
+		go w.writeLoop()
+	}
+
+	return w
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
+	if w.send == nil {
+		return w.write(v)
+	}
+
+	select {
+	case <-w.closed:
+		return websocket.ErrCloseSent
+	default:
+	}
+
+	select {
+	case w.send <- v:
+		return nil
+	default:
+		// the outbound queue is full
+		w.dropSlowConsumer()
+		return errSlowConsumer
+	}
+}
+
+func (w *wsConn) writeLoop() {
+	for {
+		select {
+		case v := <-w.send:
+			if err := w.write(v); err != nil {
+				_ = w.Close()
+				return
+			}
+		case <-w.closed:
+			return
+		}
+	}
+}
+
+// write writes the message to the connection, the connection is dropped if
+// the write timeout is exceeded.
+func (w *wsConn) write(v interface{}) error {
 	w.mux.Lock()
-	defer w.mux.Unlock()
+	if w.writeTimeout > 0 {
+		_ = w.conn.SetWriteDeadline(time.Now().Add(w.writeTimeout))
+	}
+	err := w.conn.WriteJSON(v)
+	w.mux.Unlock()
 
-	return w.conn.WriteJSON(v)
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		w.dropSlowConsumer()
+		return errSlowConsumer
+	}
+	return err
+}
+
+func (w *wsConn) dropSlowConsumer() {
+	wsSlowClientsDroppedCounter.Inc(1)
+	_ = w.Close()
 }
 
 func (w *wsConn) Close() error {
-	w.mux.Lock()
-	defer w.mux.Unlock()
+	err := websocket.ErrCloseSent
+	w.closeOnce.Do(func() {
+		close(w.closed)
+
+		w.mux.Lock()
+		defer w.mux.Unlock()
 
-	return w.conn.Close()
+		err = w.conn.Close()
+	})
+	return err
 }
 
 func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
@@ -196,6 +446,9 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 	for {
 		_, mb, err := wsConn.ReadMessage()
 		if err != nil {
+			if err == websocket.ErrReadLimit {
+				wsOversizedClientsDroppedCounter.Inc(1)
+			}
 			_ = wsConn.Close()
 			s.logger.Error("read message error, breaking read loop", "error", err.Error())
 			return
@@ -234,6 +487,20 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			continue
 		}
 
+		// the other methods are restricted by the JSON-RPC server they're forwarded to
+		if method == "eth_subscribe" || method == "eth_unsubscribe" {
+			if s.access != nil {
+				if code, err := s.access.CheckMethod(method, wsConn.authenticated); err != nil {
+					s.sendRequestErrResponse(wsConn, connID, code, err.Error())
+					continue
+				}
+			}
+			if s.limiter != nil && !s.limiter.Allow(wsConn.client, method) {
+				s.sendRequestErrResponse(wsConn, connID, ratelimit.ErrCodeLimitExceeded, fmt.Sprintf("rate limit exceeded for %s", method))
+				continue
+			}
+		}
+
 		switch method {
 		case "eth_subscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
@@ -241,13 +508,31 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				continue
 			}
 
+			if s.maxSubscriptions > 0 && len(subscriptions) >= s.maxSubscriptions {
+				wsSubscriptionsRejectedCounter.Inc(1)
+				s.sendRequestErrResponse(wsConn, connID, ratelimit.ErrCodeLimitExceeded, fmt.Sprintf("too many subscriptions, max %d per connection", s.maxSubscriptions))
+				continue
+			}
+
+			start := time.Now()
 			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
+			ready := make(chan struct{})
+			unsubFn, err := s.api.subscribe(subID, params, ready, s.notifier(wsConn, subID))
 			if err != nil {
-				s.sendErrResponse(wsConn, err.Error())
+				code := subscriptionErrorCode(err)
+				rpcmetrics.RecordCall(method, time.Since(start), code)
+				s.sendRequestErrResponse(wsConn, connID, code, err.Error())
 				continue
 			}
-			subscriptions[subID] = unsubFn
+			rpcmetrics.RecordCall(method, time.Since(start), 0)
+
+			// the subscription type is validated by subscribe
+			typ, _ := params[0].(string)
+			untrack := rpcmetrics.TrackSubscription(typ)
+			subscriptions[subID] = func() {
+				unsubFn()
+				untrack()
+			}
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -255,7 +540,9 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				Result:  subID,
 			}
 
-			if err := wsConn.WriteJSON(res); err != nil {
+			err = wsConn.WriteJSON(res)
+			close(ready)
+			if err != nil {
 				break
 			}
 		case "eth_unsubscribe":
@@ -276,6 +563,7 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				delete(subscriptions, subID)
 				unsubFn()
 			}
+			rpcmetrics.RecordCall(method, 0, 0)
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -295,6 +583,31 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 	}
 }
 
+// notifier returns the function sending the results of the subscription to the
+// connection, the connection is dropped if a notification can't be sent.
+func (s *websocketsServer) notifier(wsConn *wsConn, subID rpc.ID) func(interface{}) {
+	return func(result interface{}) {
+		res := &SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "eth_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result:       result,
+			},
+		}
+
+		if err := wsConn.WriteJSON(res); err != nil {
+			s.logger.Debug("error writing notification, will drop peer", "subscription-id", subID, "error", err.Error())
+
+			try(func() {
+				if err != websocket.ErrCloseSent {
+					_ = wsConn.Close()
+				}
+			}, s.logger, "closing websocket peer sub")
+		}
+	}
+}
+
 // tcpGetAndSendResponse sends error response to client if params is invalid
 func (s *websocketsServer) getParamsAndCheckValid(msg map[string]interface{}, wsConn *wsConn) ([]interface{}, bool) {
 	params, ok := msg["params"].([]interface{})
@@ -320,6 +633,14 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if s.limiter != nil {
+		for key, values := range wsConn.client.ForwardHeader() {
+			req.Header[key] = values
+		}
+	}
+	if s.access != nil && wsConn.authenticated {
+		req.Header.Set("Authorization", "Bearer "+s.access.Token())
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -347,43 +668,52 @@ type pubSubAPI struct {
 	events    *rpcfilters.EventSystem
 	logger    log.Logger
 	clientCtx client.Context
+	backend   rpcfilters.Backend
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, backend rpcfilters.Backend) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
 		events:    rpcfilters.NewEventSystem(logger, tmWSClient),
 		logger:    logger,
 		clientCtx: clientCtx,
+		backend:   backend,
 	}
 }
 
-func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
+// subscribe creates the subscription of the given params, its results are sent
+// with notify. The ready channel is closed once the subscription id is sent to
+// the client, the notifications which are available right away (eg. the
+// replayed logs) are held until then.
+func (api *pubSubAPI) subscribe(subID rpc.ID, params []interface{}, ready <-chan struct{}, notify func(interface{})) (pubsub.UnsubscribeFunc, error) {
 	method, ok := params[0].(string)
 	if !ok {
-		return nil, errors.New("invalid parameters")
+		return nil, invalidSubscriptionParams(errors.New("invalid parameters"))
 	}
 
 	switch method {
 	case "newHeads":
 		// TODO: handle extra params
-		return api.subscribeNewHeads(wsConn, subID)
+		return api.subscribeNewHeads(subID, notify)
 	case "logs":
 		if len(params) > 1 {
-			return api.subscribeLogs(wsConn, subID, params[1])
+			return api.subscribeLogs(subID, params[1], ready, notify)
 		}
-		return api.subscribeLogs(wsConn, subID, nil)
+		return api.subscribeLogs(subID, nil, ready, notify)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		if len(params) > 1 {
+			return api.subscribePendingTransactions(subID, params[1], notify)
+		}
+		return api.subscribePendingTransactions(subID, nil, notify)
 	case "syncing":
-		return api.subscribeSyncing(wsConn, subID)
+		return api.subscribeSyncing(subID, ready, notify)
 	default:
-		return nil, errors.Errorf("unsupported method %s", method)
+		return nil, &subscriptionError{code: middleware.ErrCodeMethodNotFound, err: errors.Errorf("unsupported method %s", method)}
 	}
 }
 
-func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+func (api *pubSubAPI) subscribeNewHeads(subID rpc.ID, notify func(interface{})) (pubsub.UnsubscribeFunc, error) {
 	sub, unsubFn, err := api.events.SubscribeNewHeads()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter")
@@ -408,28 +738,7 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 					continue
 				}
 
-				header := types.EthHeaderFromTendermint(data.Header, ethtypes.Bloom{}, baseFee)
-
-				// write to ws conn
-				res := &SubscriptionNotification{
-					Jsonrpc: "2.0",
-					Method:  "eth_subscription",
-					Params: &SubscriptionResult{
-						Subscription: subID,
-						Result:       header,
-					},
-				}
-
-				err = wsConn.WriteJSON(res)
-				if err != nil {
-					api.logger.Error("error writing header, will drop peer", "error", err.Error())
-
-					try(func() {
-						if err != websocket.ErrCloseSent {
-							_ = wsConn.Close()
-						}
-					}, api.logger, "closing websocket peer sub")
-				}
+				notify(types.EthHeaderFromTendermint(data.Header, ethtypes.Bloom{}, baseFee))
 			case err, ok := <-errCh:
 				if !ok {
 					return
@@ -459,7 +768,10 @@ func try(fn func(), l log.Logger, desc string) {
 	fn()
 }
 
-func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interface{}) (pubsub.UnsubscribeFunc, error) {
+// subscribeLogs notifies the logs matching the criteria. If the criteria has a
+// fromBlock in the past, the logs from that block up to the latest one are
+// replayed before the live logs, see logsBackfill for the boundary between both.
+func (api *pubSubAPI) subscribeLogs(subID rpc.ID, extra interface{}, ready <-chan struct{}, notify func(interface{})) (pubsub.UnsubscribeFunc, error) {
 	crit := filters.FilterCriteria{}
 
 	if extra != nil {
@@ -467,7 +779,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 		if !ok {
 			err := errors.New("invalid criteria")
 			api.logger.Debug("invalid criteria", "type", fmt.Sprintf("%T", extra))
-			return nil, err
+			return nil, invalidSubscriptionParams(err)
 		}
 
 		if params["address"] != nil {
@@ -476,7 +788,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 			if !isString && !isSlice {
 				err := errors.New("invalid addresses; must be address or array of addresses")
 				api.logger.Debug("invalid addresses", "type", fmt.Sprintf("%T", params["address"]))
-				return nil, err
+				return nil, invalidSubscriptionParams(err)
 			}
 
 			if ok {
@@ -490,7 +802,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 					if !ok {
 						err := errors.New("invalid address")
 						api.logger.Debug("invalid address", "type", fmt.Sprintf("%T", addr))
-						return nil, err
+						return nil, invalidSubscriptionParams(err)
 					}
 
 					crit.Addresses = append(crit.Addresses, common.HexToAddress(address))
@@ -498,12 +810,31 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 			}
 		}
 
+		if params["fromBlock"] != nil {
+			fromBlock, ok := params["fromBlock"].(string)
+			if !ok {
+				err := errors.New("invalid fromBlock; must be a block number or tag")
+				api.logger.Debug("invalid fromBlock", "type", fmt.Sprintf("%T", params["fromBlock"]))
+				return nil, invalidSubscriptionParams(err)
+			}
+
+			var blockNum types.BlockNumber
+			if err := blockNum.UnmarshalJSON([]byte(strconv.Quote(fromBlock))); err != nil {
+				return nil, invalidSubscriptionParams(errors.Wrap(err, "invalid fromBlock"))
+			}
+
+			// latest and pending only subscribe to the live logs
+			if blockNum >= 0 {
+				crit.FromBlock = big.NewInt(blockNum.Int64())
+			}
+		}
+
 		if params["topics"] != nil {
 			topics, ok := params["topics"].([]interface{})
 			if !ok {
 				err := errors.Errorf("invalid topics: %s", topics)
 				api.logger.Error("invalid topics", "type", fmt.Sprintf("%T", topics))
-				return nil, err
+				return nil, invalidSubscriptionParams(err)
 			}
 
 			crit.Topics = make([][]common.Hash, len(topics))
@@ -528,7 +859,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 				// in case we don't have list, but a single topic value
 				if topic, ok := subtopics.(string); ok {
 					if err := addCritTopic(topicIdx, topic); err != nil {
-						return nil, err
+						return nil, invalidSubscriptionParams(err)
 					}
 
 					continue
@@ -539,7 +870,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 				if !ok {
 					err := errors.New("invalid subtopics")
 					api.logger.Error("invalid subtopic", "type", fmt.Sprintf("%T", subtopics))
-					return nil, err
+					return nil, invalidSubscriptionParams(err)
 				}
 
 				subtopicsCollect := make([]common.Hash, len(subtopicsList))
@@ -548,7 +879,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 					if !ok {
 						err := errors.Errorf("invalid subtopic: %s", subtopic)
 						api.logger.Error("invalid subtopic", "type", fmt.Sprintf("%T", subtopic))
-						return nil, err
+						return nil, invalidSubscriptionParams(err)
 					}
 
 					subtopicsCollect[idx] = common.HexToHash(tstr)
@@ -565,11 +896,34 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 		return nil, err
 	}
 
+	notifyLogs := func(logs []*ethtypes.Log) {
+		for _, ethLog := range logs {
+			notify(ethLog)
+		}
+	}
+
+	// the live logs are consumed while the backfill is queried, otherwise they
+	// would be dropped by the event bus
+	backfillCh := make(chan backfilledLogs, 1)
+	quit := make(chan struct{})
+
 	go func() {
 		ch := sub.Event()
 		errCh := sub.Err()
+		readyCh := ready
+		var replayCh chan backfilledLogs
+		var backfill logsBackfill
 		for {
 			select {
+			case <-quit:
+				return
+			case <-readyCh:
+				// the backfill is sent after the subscription id
+				readyCh = nil
+				replayCh = backfillCh
+			case replay := <-replayCh:
+				replayCh = nil
+				notifyLogs(backfill.onBackfill(replay.logs, replay.head))
 			case event, ok := <-ch:
 				if !ok {
 					return
@@ -592,25 +946,7 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 					continue
 				}
 
-				for _, ethLog := range logs {
-					res := &SubscriptionNotification{
-						Jsonrpc: "2.0",
-						Method:  "eth_subscription",
-						Params: &SubscriptionResult{
-							Subscription: subID,
-							Result:       ethLog,
-						},
-					}
-
-					err = wsConn.WriteJSON(res)
-					if err != nil {
-						try(func() {
-							if err != websocket.ErrCloseSent {
-								_ = wsConn.Close()
-							}
-						}, api.logger, "closing websocket peer sub")
-					}
-				}
+				notifyLogs(backfill.onLive(logs))
 			case err, ok := <-errCh:
 				if !ok {
 					return
@@ -620,10 +956,63 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 		}
 	}()
 
+	if crit.FromBlock == nil {
+		backfillCh <- backfilledLogs{head: -1}
+		return unsubFn, nil
+	}
+
+	logs, head, err := api.backfillLogs(crit)
+	if err != nil {
+		close(quit)
+		unsubFn()
+		return nil, err
+	}
+
+	backfillCh <- backfilledLogs{logs: logs, head: head}
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+// backfillLogs returns the logs matching the criteria from its fromBlock up to
+// the latest block, and the height of the latest block. The query is bounded by
+// the logs and block range caps of eth_getLogs.
+func (api *pubSubAPI) backfillLogs(crit filters.FilterCriteria) ([]*ethtypes.Log, int64, error) {
+	header, err := api.backend.HeaderByNumber(types.EthLatestBlockNumber)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to get latest block")
+	}
+
+	head := header.Number.Int64()
+	if crit.FromBlock.Int64() > head {
+		return nil, head, nil
+	}
+
+	filter := rpcfilters.NewRangeFilter(api.logger, api.backend, crit.FromBlock.Int64(), head, crit.Addresses, crit.Topics)
+	logs, err := filter.Logs(context.Background(), int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()))
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to backfill logs")
+	}
+	return logs, head, nil
+}
+
+func (api *pubSubAPI) subscribePendingTransactions(subID rpc.ID, extra interface{}, notify func(interface{})) (pubsub.UnsubscribeFunc, error) {
+	var crit rpcfilters.PendingTxCriteria
+	if extra != nil {
+		// the criteria is either the full tx flag or an object with the address filters
+		bz, err := json.Marshal(extra)
+		if err != nil {
+			return nil, invalidSubscriptionParams(err)
+		}
+		if err := json.Unmarshal(bz, &crit); err != nil {
+			api.logger.Debug("invalid pending transactions criteria", "type", fmt.Sprintf("%T", extra))
+			return nil, invalidSubscriptionParams(err)
+		}
+	}
+
+	chainID, err := crit.ChainID(api.clientCtx.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter: %s")
@@ -648,25 +1037,13 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 				}
 
 				for _, ethTx := range ethTxs {
-					// write to ws conn
-					res := &SubscriptionNotification{
-						Jsonrpc: "2.0",
-						Method:  "eth_subscription",
-						Params: &SubscriptionResult{
-							Subscription: subID,
-							Result:       ethTx.Hash,
-						},
-					}
-
-					err = wsConn.WriteJSON(res)
+					result, matched, err := crit.Result(ethTx, chainID)
 					if err != nil {
-						api.logger.Debug("error writing header, will drop peer", "error", err.Error())
-
-						try(func() {
-							if err != websocket.ErrCloseSent {
-								_ = wsConn.Close()
-							}
-						}, api.logger, "closing websocket peer sub")
+						api.logger.Debug("failed to build pending tx notification", "error", err.Error())
+						continue
+					}
+					if matched {
+						notify(result)
 					}
 				}
 			case err, ok := <-errCh:
@@ -681,8 +1058,71 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) { //nolint: revive
-	return nil, errors.New("syncing subscription is not implemented")
+// subscribeSyncing notifies the changes of the catch-up state of the node. The sync
+// status is notified when the node starts catching up, and false when it's caught
+// up, the current state is notified after the subscription id if the node is
+// catching up.
+func (api *pubSubAPI) subscribeSyncing(subID rpc.ID, ready <-chan struct{}, notify func(interface{})) (pubsub.UnsubscribeFunc, error) {
+	if api.clientCtx.Client == nil {
+		return nil, errors.New("syncing subscription requires a node client")
+	}
+
+	status, err := api.clientCtx.Client.Status(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get node status")
+	}
+
+	sub, unsubFn, err := api.events.SubscribeNewHeads()
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating block filter")
+	}
+
+	go api.notifySyncing(subID, status.SyncInfo, sub.Event(), sub.Err(), ready, notify)
+
+	return unsubFn, nil
+}
+
+// notifySyncing notifies the catch-up state of the given sync info once the
+// subscription id is sent, then the changes of the catch-up state on every header.
+func (api *pubSubAPI) notifySyncing(
+	subID rpc.ID,
+	info coretypes.SyncInfo,
+	headersCh <-chan coretypes.ResultEvent,
+	errCh <-chan error,
+	ready <-chan struct{},
+	notify func(interface{}),
+) {
+	var tracker types.SyncingTracker
+	// the headers received until the subscription id is sent are held by the
+	// subscription
+	<-ready
+	if result, ok := tracker.Update(info); ok {
+		notify(result)
+	}
+
+	for {
+		select {
+		case _, ok := <-headersCh:
+			if !ok {
+				return
+			}
+
+			status, err := api.clientCtx.Client.Status(context.Background())
+			if err != nil {
+				api.logger.Debug("failed to get node status", "error", err.Error())
+				continue
+			}
+
+			if result, ok := tracker.Update(status.SyncInfo); ok {
+				notify(result)
+			}
+		case err, ok := <-errCh:
+			if !ok {
+				return
+			}
+			api.logger.Debug("dropping Syncing WebSocket subscription", "subscription-id", subID, "error", err.Error())
+		}
+	}
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
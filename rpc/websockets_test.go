@@ -0,0 +1,125 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc/types"
+)
+
+// dialTestServer starts the websocket server and returns a client connection.
+func dialTestServer(t *testing.T, s *websocketsServer) *websocket.Conn {
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestWebsocketsMaxMessageSize(t *testing.T) {
+	s := &websocketsServer{logger: log.NewNopLogger(), maxMessageSize: 16}
+	conn := dialTestServer(t, s)
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`)))
+
+	_, _, err := conn.ReadMessage()
+	require.True(t, websocket.IsCloseError(err, websocket.CloseMessageTooBig), err)
+}
+
+func TestWebsocketsSubscribeErrors(t *testing.T) {
+	s := &websocketsServer{logger: log.NewNopLogger(), api: &pubSubAPI{logger: log.NewNopLogger()}}
+	conn := dialTestServer(t, s)
+
+	testCases := []struct {
+		name    string
+		params  string
+		expCode int
+	}{
+		{"unsupported subscription", `["unknown"]`, -32601},
+		{"invalid subscription type", `[1]`, -32602},
+		{"invalid logs criteria", `["logs","criteria"]`, -32602},
+		{"invalid pending txs criteria", `["newPendingTransactions","fullTx"]`, -32602},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"eth_subscribe","params":%s}`, i, tc.params)
+			require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(req)))
+
+			var res ErrorResponseJSON
+			require.NoError(t, conn.ReadJSON(&res))
+			require.Equal(t, big.NewInt(int64(i)), res.ID)
+			require.Equal(t, big.NewInt(int64(tc.expCode)), res.Error.Code)
+		})
+	}
+}
+
+func TestWsConnOutboundQueueFull(t *testing.T) {
+	conn := dialTestServer(t, &websocketsServer{logger: log.NewNopLogger()})
+
+	// the write loop isn't started, the queue is never drained
+	w := newWsConn(conn, 0, 0)
+	w.send = make(chan interface{}, 1)
+
+	require.NoError(t, w.WriteJSON("first"))
+	require.ErrorIs(t, w.WriteJSON("second"), errSlowConsumer)
+	require.ErrorIs(t, w.WriteJSON("third"), websocket.ErrCloseSent)
+}
+
+// statusClient serves the node status of its sync info.
+type statusClient struct {
+	client.TendermintRPC
+
+	info coretypes.SyncInfo
+}
+
+func (c *statusClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: c.info}, nil
+}
+
+func TestNotifySyncing(t *testing.T) {
+	node := &statusClient{}
+	api := &pubSubAPI{logger: log.NewNopLogger(), clientCtx: client.Context{Client: node}}
+
+	headersCh := make(chan coretypes.ResultEvent)
+	ready := make(chan struct{})
+	notifications := make(chan interface{}, 1)
+	go api.notifySyncing(
+		rpc.NewID(),
+		coretypes.SyncInfo{CatchingUp: true, EarliestBlockHeight: 1, LatestBlockHeight: 10},
+		headersCh, nil, ready,
+		func(result interface{}) { notifications <- result },
+	)
+
+	// the current state is only notified after the subscription id
+	select {
+	case <-notifications:
+		t.Fatal("notified before the subscription id is sent")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(ready)
+	require.Equal(t, types.NewSyncingResult(1, 10), <-notifications)
+
+	node.info = coretypes.SyncInfo{LatestBlockHeight: 20}
+	headersCh <- coretypes.ResultEvent{}
+	require.Equal(t, false, <-notifications)
+
+	node.info = coretypes.SyncInfo{CatchingUp: true, LatestBlockHeight: 22}
+	headersCh <- coretypes.ResultEvent{}
+	require.Equal(t, types.NewSyncingResult(22, 22), <-notifications)
+	close(headersCh)
+}
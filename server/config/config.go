@@ -19,6 +19,8 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"strconv"
+	stdstrings "strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -58,6 +60,8 @@ const (
 
 	DefaultBlockRangeCap int32 = 10000
 
+	DefaultTraceFilterBlockRangeCap int32 = 100
+
 	DefaultEVMTimeout = 5 * time.Second
 	// default 1.0 eth
 	DefaultTxFeeCap float64 = 1.0
@@ -69,10 +73,49 @@ const (
 	DefaultAllowUnprotectedTxs = false
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
+
+	// DefaultWSMaxSubscriptions is the default max number of subscriptions of a WebSocket connection
+	DefaultWSMaxSubscriptions = 100
+	// DefaultWSMaxMessageSize is the default max size in bytes of a message received from a
+	// WebSocket connection, same as go-ethereum
+	DefaultWSMaxMessageSize int64 = 15 * 1024 * 1024
+	// DefaultWSMaxOutboundQueue is the default max number of messages queued to be sent to a
+	// WebSocket connection
+	DefaultWSMaxOutboundQueue = 1024
+	// DefaultWSSlowConsumerTimeout is the default time a WebSocket connection has to read a
+	// message sent to it before being disconnected
+	DefaultWSSlowConsumerTimeout = 10 * time.Second
+
+	// DefaultRateLimitPerIP is the default number of requests per second allowed per client IP
+	DefaultRateLimitPerIP float64 = 50
+	// DefaultRateLimitBurst is the default number of requests a client can send at once
+	DefaultRateLimitBurst = 100
+
+	// DefaultResponseCacheSize is the default max number of historical responses cached
+	DefaultResponseCacheSize = 10000
+
+	// DefaultBatchRequestLimit is the default max number of requests of a batch, same as go-ethereum
+	DefaultBatchRequestLimit = 1000
+	// DefaultBatchResponseMaxSize is the default max size in bytes of the responses of a batch,
+	// same as go-ethereum
+	DefaultBatchResponseMaxSize = 25 * 1000 * 1000
+
+	// IndexerBackendKV stores the custom eth tx index in a key-value db, using the
+	// same db backend as the main app
+	IndexerBackendKV = "kv"
+	// IndexerBackendSQL stores the custom eth tx index in an embedded SQLite db
+	IndexerBackendSQL = "sql"
+
+	// DefaultIndexerBackend is the default backend of the custom eth tx indexer
+	DefaultIndexerBackend = IndexerBackendKV
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
 
+// DefaultRateLimitMethods are the default per-method rate limits, the expensive
+// methods are limited much more than the rest.
+var DefaultRateLimitMethods = []string{"eth_getLogs=5", "debug_*=1", "trace_*=1"}
+
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
 type Config struct {
@@ -100,6 +143,15 @@ type JSONRPCConfig struct {
 	Address string `mapstructure:"address"`
 	// WsAddress defines the WebSocket server to listen on
 	WsAddress string `mapstructure:"ws-address"`
+	// WSOnHTTP enables the WebSocket connections on the HTTP server address,
+	// served by the JSON-RPC server
+	WSOnHTTP bool `mapstructure:"ws-on-http"`
+	// WSOrigins defines the origins the WebSocket connections are accepted
+	// from, "*" accepts all of them
+	WSOrigins []string `mapstructure:"ws-origins"`
+	// IPCPath defines the path of the IPC socket, relative to the node home
+	// directory unless absolute. The IPC server is disabled if empty
+	IPCPath string `mapstructure:"ipc-path"`
 	// GasCap is the global gas cap for eth-call variants.
 	GasCap uint64 `mapstructure:"gas-cap"`
 	// EVMTimeout is the global timeout for eth-call.
@@ -116,6 +168,9 @@ type JSONRPCConfig struct {
 	LogsCap int32 `mapstructure:"logs-cap"`
 	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query.
 	BlockRangeCap int32 `mapstructure:"block-range-cap"`
+	// TraceFilterBlockRangeCap defines the max block range allowed for
+	// `trace_filter` query, every block of the range is re-executed.
+	TraceFilterBlockRangeCap int32 `mapstructure:"trace-filter-block-range-cap"`
 	// HTTPTimeout is the read/write timeout of http json-rpc server.
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
 	// HTTPIdleTimeout is the idle timeout of http json-rpc server.
@@ -126,8 +181,63 @@ type JSONRPCConfig struct {
 	// MaxOpenConnections sets the maximum number of simultaneous connections
 	// for the server listener.
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
+	// WSMaxSubscriptions sets the maximum number of subscriptions of a WebSocket
+	// connection (0 = unlimited).
+	WSMaxSubscriptions int `mapstructure:"ws-max-subscriptions"`
+	// WSMaxMessageSize sets the maximum size in bytes of a message received from a
+	// WebSocket connection, the connection is closed if exceeded (0 = unlimited).
+	WSMaxMessageSize int64 `mapstructure:"ws-max-message-size"`
+	// WSMaxOutboundQueue sets the maximum number of messages queued to be sent to a
+	// connection of the WebSocket server address, the connection is closed if
+	// exceeded (0 = messages are written without queueing).
+	WSMaxOutboundQueue int `mapstructure:"ws-max-outbound-queue"`
+	// WSSlowConsumerTimeout sets the time a WebSocket connection has to read a message
+	// sent to it, the connection is closed if exceeded (0 = unlimited).
+	WSSlowConsumerTimeout time.Duration `mapstructure:"ws-slow-consumer-timeout"`
+	// RateLimitEnable defines if the requests are rate limited per client IP or API key.
+	RateLimitEnable bool `mapstructure:"rate-limit-enable"`
+	// RateLimitPerIP sets the number of requests per second allowed per client IP.
+	RateLimitPerIP float64 `mapstructure:"rate-limit-per-ip"`
+	// RateLimitBurst sets the number of requests a client can send at once.
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// RateLimitMethods sets the number of requests per second allowed per client for
+	// the given methods, as `method=rate` entries. The method can end with a `*`
+	// wildcard, eg. `debug_*=1`.
+	RateLimitMethods []string `mapstructure:"rate-limit-methods"`
+	// RateLimitAPIKeys sets the number of requests per second allowed for the given
+	// API keys, as `key=rate` entries. The clients sending a key are limited by its
+	// quota instead of the per IP one.
+	RateLimitAPIKeys []string `mapstructure:"rate-limit-api-keys"`
+	// AllowedMethods restricts the methods which can be called to the given ones,
+	// all the methods of the enabled namespaces can be called if empty. The method
+	// can end with a `*` wildcard, eg. `debug_trace*`.
+	AllowedMethods []string `mapstructure:"allowed-methods"`
+	// DeniedMethods defines the methods which can't be called, it has precedence
+	// over the allowed methods.
+	DeniedMethods []string `mapstructure:"denied-methods"`
+	// AuthJWTSecret defines the path of the hex encoded JWT secret, relative to
+	// the node home directory unless absolute. If set, the methods of the
+	// non-public namespaces require a HS256 token signed with the secret. The
+	// secret is generated if the file doesn't exist.
+	AuthJWTSecret string `mapstructure:"auth-jwt-secret"`
+	// ResponseCacheSize sets the maximum number of responses of the queries of
+	// historical blocks, receipts, traces and logs cached in memory (0 = disabled).
+	ResponseCacheSize int `mapstructure:"response-cache-size"`
+	// BatchRequestLimit sets the maximum number of requests of a batch (0 = unlimited).
+	BatchRequestLimit int `mapstructure:"batch-request-limit"`
+	// BatchResponseMaxSize sets the maximum size in bytes of the responses of a
+	// batch, the requests served once it's exceeded reply with an error (0 = unlimited).
+	BatchResponseMaxSize int `mapstructure:"batch-response-max-size"`
+	// BatchParallelWorkers sets the maximum number of read-only requests of the
+	// batches served concurrently (0 = the requests are served sequentially).
+	BatchParallelWorkers int `mapstructure:"batch-parallel-workers"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// IndexerBackend defines the storage backend of the custom indexer service.
+	IndexerBackend string `mapstructure:"indexer-backend"`
+	// EnableLogIndexer defines if the custom indexer service also indexes the addresses and
+	// topics of the eth logs, which is only supported by the kv indexer backend.
+	EnableLogIndexer bool `mapstructure:"enable-log-indexer"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 }
@@ -209,29 +319,49 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
 func DefaultJSONRPCConfig() *JSONRPCConfig {
 	return &JSONRPCConfig{
-		Enable:              true,
-		API:                 GetDefaultAPINamespaces(),
-		Address:             DefaultJSONRPCAddress,
-		WsAddress:           DefaultJSONRPCWsAddress,
-		GasCap:              DefaultGasCap,
-		EVMTimeout:          DefaultEVMTimeout,
-		TxFeeCap:            DefaultTxFeeCap,
-		FilterCap:           DefaultFilterCap,
-		FeeHistoryCap:       DefaultFeeHistoryCap,
-		BlockRangeCap:       DefaultBlockRangeCap,
-		LogsCap:             DefaultLogsCap,
-		HTTPTimeout:         DefaultHTTPTimeout,
-		HTTPIdleTimeout:     DefaultHTTPIdleTimeout,
-		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
-		MaxOpenConnections:  DefaultMaxOpenConnections,
-		EnableIndexer:       false,
-		MetricsAddress:      DefaultJSONRPCMetricsAddress,
+		Enable:                   true,
+		API:                      GetDefaultAPINamespaces(),
+		Address:                  DefaultJSONRPCAddress,
+		WsAddress:                DefaultJSONRPCWsAddress,
+		WSOrigins:                []string{"*"},
+		GasCap:                   DefaultGasCap,
+		EVMTimeout:               DefaultEVMTimeout,
+		TxFeeCap:                 DefaultTxFeeCap,
+		FilterCap:                DefaultFilterCap,
+		FeeHistoryCap:            DefaultFeeHistoryCap,
+		BlockRangeCap:            DefaultBlockRangeCap,
+		TraceFilterBlockRangeCap: DefaultTraceFilterBlockRangeCap,
+		LogsCap:                  DefaultLogsCap,
+		HTTPTimeout:              DefaultHTTPTimeout,
+		HTTPIdleTimeout:          DefaultHTTPIdleTimeout,
+		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
+		MaxOpenConnections:       DefaultMaxOpenConnections,
+		WSMaxSubscriptions:       DefaultWSMaxSubscriptions,
+		WSMaxMessageSize:         DefaultWSMaxMessageSize,
+		WSMaxOutboundQueue:       DefaultWSMaxOutboundQueue,
+		WSSlowConsumerTimeout:    DefaultWSSlowConsumerTimeout,
+		RateLimitEnable:          false,
+		RateLimitPerIP:           DefaultRateLimitPerIP,
+		RateLimitBurst:           DefaultRateLimitBurst,
+		RateLimitMethods:         DefaultRateLimitMethods,
+		RateLimitAPIKeys:         []string{},
+		AllowedMethods:           []string{},
+		DeniedMethods:            []string{},
+		AuthJWTSecret:            "",
+		ResponseCacheSize:        DefaultResponseCacheSize,
+		BatchRequestLimit:        DefaultBatchRequestLimit,
+		BatchResponseMaxSize:     DefaultBatchResponseMaxSize,
+		BatchParallelWorkers:     0,
+		EnableIndexer:            false,
+		IndexerBackend:           DefaultIndexerBackend,
+		EnableLogIndexer:         false,
+		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 	}
 }
 
@@ -265,6 +395,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC block range cap cannot be negative")
 	}
 
+	if c.TraceFilterBlockRangeCap < 0 {
+		return errors.New("JSON-RPC trace filter block range cap cannot be negative")
+	}
+
 	if c.HTTPTimeout < 0 {
 		return errors.New("JSON-RPC HTTP timeout duration cannot be negative")
 	}
@@ -273,6 +407,73 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.WSMaxSubscriptions < 0 {
+		return errors.New("JSON-RPC WebSocket max subscriptions cannot be negative")
+	}
+
+	if c.WSMaxMessageSize < 0 {
+		return errors.New("JSON-RPC WebSocket max message size cannot be negative")
+	}
+
+	if c.WSMaxOutboundQueue < 0 {
+		return errors.New("JSON-RPC WebSocket max outbound queue cannot be negative")
+	}
+
+	if c.WSSlowConsumerTimeout < 0 {
+		return errors.New("JSON-RPC WebSocket slow consumer timeout duration cannot be negative")
+	}
+
+	if c.RateLimitEnable {
+		if c.RateLimitPerIP <= 0 {
+			return errors.New("JSON-RPC rate limit per IP must be positive")
+		}
+
+		if c.RateLimitBurst <= 0 {
+			return errors.New("JSON-RPC rate limit burst must be positive")
+		}
+
+		if _, err := c.MethodRateLimits(); err != nil {
+			return fmt.Errorf("invalid JSON-RPC method rate limits: %w", err)
+		}
+
+		if _, err := c.APIKeyRateLimits(); err != nil {
+			return fmt.Errorf("invalid JSON-RPC API key rate limits: %w", err)
+		}
+	}
+
+	for _, method := range append(append([]string{}, c.AllowedMethods...), c.DeniedMethods...) {
+		if method == "" || stdstrings.Contains(stdstrings.TrimSuffix(method, "*"), "*") {
+			return fmt.Errorf("invalid JSON-RPC method '%s', only a trailing '*' wildcard is supported", method)
+		}
+	}
+
+	if c.ResponseCacheSize < 0 {
+		return errors.New("JSON-RPC response cache size cannot be negative")
+	}
+
+	if c.BatchRequestLimit < 0 {
+		return errors.New("JSON-RPC batch request limit cannot be negative")
+	}
+
+	if c.BatchResponseMaxSize < 0 {
+		return errors.New("JSON-RPC batch response max size cannot be negative")
+	}
+
+	if c.BatchParallelWorkers < 0 {
+		return errors.New("JSON-RPC batch parallel workers cannot be negative")
+	}
+
+	switch c.IndexerBackend {
+	// an empty backend falls back to kv for the config files created before the option existed
+	case "", IndexerBackendKV, IndexerBackendSQL:
+	default:
+		return fmt.Errorf("invalid JSON-RPC indexer backend '%s', expected %s or %s", c.IndexerBackend, IndexerBackendKV, IndexerBackendSQL)
+	}
+
+	if c.EnableLogIndexer && c.IndexerBackend == IndexerBackendSQL {
+		return errors.New("JSON-RPC log indexer is not supported by the sql indexer backend")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -286,6 +487,39 @@ func (c JSONRPCConfig) Validate() error {
 	return nil
 }
 
+// MethodRateLimits returns the rate limits of the methods by method name.
+func (c JSONRPCConfig) MethodRateLimits() (map[string]float64, error) {
+	return parseRateLimits(c.RateLimitMethods)
+}
+
+// APIKeyRateLimits returns the rate limits of the API keys by key.
+func (c JSONRPCConfig) APIKeyRateLimits() (map[string]float64, error) {
+	return parseRateLimits(c.RateLimitAPIKeys)
+}
+
+// parseRateLimits parses the `name=rate` entries of a rate limit list.
+func parseRateLimits(entries []string) (map[string]float64, error) {
+	limits := make(map[string]float64, len(entries))
+	for _, entry := range entries {
+		name, rate, ok := stdstrings.Cut(entry, "=")
+		name = stdstrings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid rate limit '%s', expected name=rate", entry)
+		}
+
+		limit, err := strconv.ParseFloat(stdstrings.TrimSpace(rate), 64)
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("invalid rate limit '%s', the rate must be a positive number", entry)
+		}
+
+		if _, found := limits[name]; found {
+			return nil, fmt.Errorf("repeated rate limit '%s'", name)
+		}
+		limits[name] = limit
+	}
+	return limits, nil
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -325,22 +559,44 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			MaxTxGasWanted: v.GetUint64("evm.max-tx-gas-wanted"),
 		},
 		JSONRPC: JSONRPCConfig{
-			Enable:             v.GetBool("json-rpc.enable"),
-			API:                v.GetStringSlice("json-rpc.api"),
-			Address:            v.GetString("json-rpc.address"),
-			WsAddress:          v.GetString("json-rpc.ws-address"),
-			GasCap:             v.GetUint64("json-rpc.gas-cap"),
-			FilterCap:          v.GetInt32("json-rpc.filter-cap"),
-			FeeHistoryCap:      v.GetInt32("json-rpc.feehistory-cap"),
-			TxFeeCap:           v.GetFloat64("json-rpc.txfee-cap"),
-			EVMTimeout:         v.GetDuration("json-rpc.evm-timeout"),
-			LogsCap:            v.GetInt32("json-rpc.logs-cap"),
-			BlockRangeCap:      v.GetInt32("json-rpc.block-range-cap"),
-			HTTPTimeout:        v.GetDuration("json-rpc.http-timeout"),
-			HTTPIdleTimeout:    v.GetDuration("json-rpc.http-idle-timeout"),
-			MaxOpenConnections: v.GetInt("json-rpc.max-open-connections"),
-			EnableIndexer:      v.GetBool("json-rpc.enable-indexer"),
-			MetricsAddress:     v.GetString("json-rpc.metrics-address"),
+			Enable:                   v.GetBool("json-rpc.enable"),
+			API:                      v.GetStringSlice("json-rpc.api"),
+			Address:                  v.GetString("json-rpc.address"),
+			WsAddress:                v.GetString("json-rpc.ws-address"),
+			WSOnHTTP:                 v.GetBool("json-rpc.ws-on-http"),
+			WSOrigins:                v.GetStringSlice("json-rpc.ws-origins"),
+			IPCPath:                  v.GetString("json-rpc.ipc-path"),
+			GasCap:                   v.GetUint64("json-rpc.gas-cap"),
+			FilterCap:                v.GetInt32("json-rpc.filter-cap"),
+			FeeHistoryCap:            v.GetInt32("json-rpc.feehistory-cap"),
+			TxFeeCap:                 v.GetFloat64("json-rpc.txfee-cap"),
+			EVMTimeout:               v.GetDuration("json-rpc.evm-timeout"),
+			LogsCap:                  v.GetInt32("json-rpc.logs-cap"),
+			BlockRangeCap:            v.GetInt32("json-rpc.block-range-cap"),
+			TraceFilterBlockRangeCap: v.GetInt32("json-rpc.trace-filter-block-range-cap"),
+			HTTPTimeout:              v.GetDuration("json-rpc.http-timeout"),
+			HTTPIdleTimeout:          v.GetDuration("json-rpc.http-idle-timeout"),
+			MaxOpenConnections:       v.GetInt("json-rpc.max-open-connections"),
+			WSMaxSubscriptions:       v.GetInt("json-rpc.ws-max-subscriptions"),
+			WSMaxMessageSize:         v.GetInt64("json-rpc.ws-max-message-size"),
+			WSMaxOutboundQueue:       v.GetInt("json-rpc.ws-max-outbound-queue"),
+			WSSlowConsumerTimeout:    v.GetDuration("json-rpc.ws-slow-consumer-timeout"),
+			RateLimitEnable:          v.GetBool("json-rpc.rate-limit-enable"),
+			RateLimitPerIP:           v.GetFloat64("json-rpc.rate-limit-per-ip"),
+			RateLimitBurst:           v.GetInt("json-rpc.rate-limit-burst"),
+			RateLimitMethods:         v.GetStringSlice("json-rpc.rate-limit-methods"),
+			RateLimitAPIKeys:         v.GetStringSlice("json-rpc.rate-limit-api-keys"),
+			AllowedMethods:           v.GetStringSlice("json-rpc.allowed-methods"),
+			DeniedMethods:            v.GetStringSlice("json-rpc.denied-methods"),
+			AuthJWTSecret:            v.GetString("json-rpc.auth-jwt-secret"),
+			ResponseCacheSize:        v.GetInt("json-rpc.response-cache-size"),
+			BatchRequestLimit:        v.GetInt("json-rpc.batch-request-limit"),
+			BatchResponseMaxSize:     v.GetInt("json-rpc.batch-response-max-size"),
+			BatchParallelWorkers:     v.GetInt("json-rpc.batch-parallel-workers"),
+			EnableIndexer:            v.GetBool("json-rpc.enable-indexer"),
+			IndexerBackend:           v.GetString("json-rpc.indexer-backend"),
+			EnableLogIndexer:         v.GetBool("json-rpc.enable-log-indexer"),
+			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -12,3 +13,139 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestJSONRPCConfigValidateWSLimits(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*JSONRPCConfig)
+		expPass  bool
+	}{
+		{"default", func(*JSONRPCConfig) {}, true},
+		{"limits disabled", func(c *JSONRPCConfig) {
+			c.WSMaxSubscriptions = 0
+			c.WSMaxMessageSize = 0
+			c.WSMaxOutboundQueue = 0
+			c.WSSlowConsumerTimeout = 0
+		}, true},
+		{"negative max subscriptions", func(c *JSONRPCConfig) { c.WSMaxSubscriptions = -1 }, false},
+		{"negative max message size", func(c *JSONRPCConfig) { c.WSMaxMessageSize = -1 }, false},
+		{"negative max outbound queue", func(c *JSONRPCConfig) { c.WSMaxOutboundQueue = -1 }, false},
+		{"negative slow consumer timeout", func(c *JSONRPCConfig) { c.WSSlowConsumerTimeout = -time.Second }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			tc.malleate(cfg)
+			err := cfg.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestJSONRPCConfigRateLimits(t *testing.T) {
+	testCases := []struct {
+		name      string
+		methods   []string
+		expLimits map[string]float64
+		expPass   bool
+	}{
+		{"default", DefaultRateLimitMethods, map[string]float64{"eth_getLogs": 5, "debug_*": 1, "trace_*": 1}, true},
+		{"empty", []string{}, map[string]float64{}, true},
+		{"spaces", []string{" eth_call = 0.5 "}, map[string]float64{"eth_call": 0.5}, true},
+		{"missing rate", []string{"eth_call"}, nil, false},
+		{"missing name", []string{"=1"}, nil, false},
+		{"invalid rate", []string{"eth_call=fast"}, nil, false},
+		{"zero rate", []string{"eth_call=0"}, nil, false},
+		{"repeated name", []string{"eth_call=1", "eth_call=2"}, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			cfg.RateLimitEnable = true
+			cfg.RateLimitMethods = tc.methods
+
+			limits, err := cfg.MethodRateLimits()
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expLimits, limits)
+				require.NoError(t, cfg.Validate())
+			} else {
+				require.Error(t, err)
+				require.Error(t, cfg.Validate())
+			}
+		})
+	}
+}
+
+func TestJSONRPCConfigValidateMethods(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*JSONRPCConfig)
+		expPass  bool
+	}{
+		{"method lists", func(c *JSONRPCConfig) {
+			c.AllowedMethods = []string{"eth_*", "debug_trace*"}
+			c.DeniedMethods = []string{"debug_cpuProfile"}
+		}, true},
+		{"empty method", func(c *JSONRPCConfig) { c.DeniedMethods = []string{""} }, false},
+		{"inner wildcard", func(c *JSONRPCConfig) { c.AllowedMethods = []string{"debug_*Profile"} }, false},
+		{"method lists with ws-on-http", func(c *JSONRPCConfig) {
+			c.WSOnHTTP = true
+			c.DeniedMethods = []string{"debug_cpuProfile"}
+		}, true},
+		{"auth with ws-on-http", func(c *JSONRPCConfig) {
+			c.WSOnHTTP = true
+			c.AuthJWTSecret = "config/jwt.hex"
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			tc.malleate(cfg)
+			err := cfg.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestJSONRPCConfigValidateBatchLimits(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*JSONRPCConfig)
+		expPass  bool
+	}{
+		{"default", func(*JSONRPCConfig) {}, true},
+		{"limits disabled", func(c *JSONRPCConfig) {
+			c.BatchRequestLimit = 0
+			c.BatchResponseMaxSize = 0
+		}, true},
+		{"parallel workers", func(c *JSONRPCConfig) { c.BatchParallelWorkers = 8 }, true},
+		{"negative request limit", func(c *JSONRPCConfig) { c.BatchRequestLimit = -1 }, false},
+		{"negative response max size", func(c *JSONRPCConfig) { c.BatchResponseMaxSize = -1 }, false},
+		{"negative parallel workers", func(c *JSONRPCConfig) { c.BatchParallelWorkers = -1 }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := DefaultJSONRPCConfig()
+			tc.malleate(cfg)
+			err := cfg.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
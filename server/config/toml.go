@@ -46,6 +46,19 @@ address = "{{ .JSONRPC.Address }}"
 # Address defines the EVM WebSocket server address to bind to.
 ws-address = "{{ .JSONRPC.WsAddress }}"
 
+# WSOnHTTP enables the WebSocket connections on the JSON-RPC server address, served by the JSON-RPC
+# server with the same methods and subscriptions as the WebSocket server address.
+ws-on-http = {{ .JSONRPC.WSOnHTTP }}
+
+# WSOrigins defines the origins the WebSocket connections are accepted from, "*" accepts all of them.
+# The connections sent without an Origin header, ie. not by a browser, are always accepted.
+ws-origins = [{{range $index, $elmt := .JSONRPC.WSOrigins}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# IPCPath defines the path of the IPC socket, relative to the node home directory unless absolute.
+# The IPC connections are served by the JSON-RPC server, with the limits of the WebSocket connections.
+# The IPC server is disabled if empty.
+ipc-path = "{{ .JSONRPC.IPCPath }}"
+
 # API defines a list of JSON-RPC namespaces that should be enabled
 # Example: "eth,txpool,personal,net,debug,web3"
 api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
@@ -71,6 +84,11 @@ logs-cap = {{ .JSONRPC.LogsCap }}
 # BlockRangeCap defines the max block range allowed for 'eth_getLogs' query.
 block-range-cap = {{ .JSONRPC.BlockRangeCap }}
 
+# TraceFilterBlockRangeCap defines the max block range allowed for 'trace_filter' query.
+# Every block of the range is re-executed to trace its txs, so the cost of a query grows
+# with the range and the number of txs of its blocks: keep it small on public nodes.
+trace-filter-block-range-cap = {{ .JSONRPC.TraceFilterBlockRangeCap }}
+
 # HTTPTimeout is the read/write timeout of http json-rpc server.
 http-timeout = "{{ .JSONRPC.HTTPTimeout }}"
 
@@ -85,9 +103,86 @@ allow-unprotected-txs = {{ .JSONRPC.AllowUnprotectedTxs }}
 # for the server listener.
 max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 
+# WSMaxSubscriptions sets the maximum number of subscriptions of a WebSocket connection (0=unlimited).
+ws-max-subscriptions = {{ .JSONRPC.WSMaxSubscriptions }}
+
+# WSMaxMessageSize sets the maximum size in bytes of a message received from a WebSocket connection,
+# the connection is closed if exceeded (0=unlimited).
+ws-max-message-size = {{ .JSONRPC.WSMaxMessageSize }}
+
+# WSMaxOutboundQueue sets the maximum number of messages queued to be sent to a connection of the
+# WebSocket server address, the connection is closed if exceeded (0=messages are written without
+# queueing). The connections served by the JSON-RPC server, IPC and ws-on-http, aren't queued.
+ws-max-outbound-queue = {{ .JSONRPC.WSMaxOutboundQueue }}
+
+# WSSlowConsumerTimeout sets the time a WebSocket connection has to read a message sent to it,
+# the connection is closed if exceeded (0=unlimited).
+ws-slow-consumer-timeout = "{{ .JSONRPC.WSSlowConsumerTimeout }}"
+
+# RateLimitEnable defines if the requests are rate limited per client IP or API key. The API key is
+# sent in the X-API-Key header or the apikey URL query parameter. The limits apply to each message of
+# the WebSocket and IPC connections, the IPC clients share the per IP rate.
+rate-limit-enable = {{ .JSONRPC.RateLimitEnable }}
+
+# RateLimitPerIP sets the number of requests per second allowed per client IP.
+rate-limit-per-ip = {{ .JSONRPC.RateLimitPerIP }}
+
+# RateLimitBurst sets the number of requests a client can send at once.
+rate-limit-burst = {{ .JSONRPC.RateLimitBurst }}
+
+# RateLimitMethods sets the number of requests per second allowed per client for the given methods,
+# in addition to the per client limit. The method can end with a wildcard, eg. "debug_*=1".
+rate-limit-methods = [{{range $index, $elmt := .JSONRPC.RateLimitMethods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# RateLimitAPIKeys sets the number of requests per second allowed for the given API keys, as "key=rate"
+# entries. The clients sending a key are limited by its quota instead of the per IP one.
+rate-limit-api-keys = [{{range $index, $elmt := .JSONRPC.RateLimitAPIKeys}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# AllowedMethods restricts the methods which can be called to the given ones, all the methods of the
+# enabled namespaces can be called if empty. The method can end with a wildcard, eg. "debug_trace*".
+# The method lists apply to the IPC socket too, its clients are authenticated.
+allowed-methods = [{{range $index, $elmt := .JSONRPC.AllowedMethods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# DeniedMethods defines the methods which can't be called, it has precedence over the allowed methods.
+# Example: ["debug_cpuProfile", "debug_writeMemProfile"]
+denied-methods = [{{range $index, $elmt := .JSONRPC.DeniedMethods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# AuthJWTSecret defines the path of the hex encoded JWT secret, relative to the node home directory
+# unless absolute. If set, the methods of the non-public namespaces (personal, miner) require a HS256
+# token signed with the secret in the Authorization header, sent by the WebSocket clients when
+# connecting. The secret is generated if the file doesn't exist.
+auth-jwt-secret = "{{ .JSONRPC.AuthJWTSecret }}"
+
+# ResponseCacheSize sets the maximum number of responses of the queries of historical blocks, receipts,
+# traces and logs cached in memory (0=disabled).
+response-cache-size = {{ .JSONRPC.ResponseCacheSize }}
+
+# BatchRequestLimit sets the maximum number of requests of a batch (0=unlimited).
+batch-request-limit = {{ .JSONRPC.BatchRequestLimit }}
+
+# BatchResponseMaxSize sets the maximum size in bytes of the responses of a batch, the requests served
+# once it's exceeded reply with an error (0=unlimited).
+batch-response-max-size = {{ .JSONRPC.BatchResponseMaxSize }}
+
+# BatchParallelWorkers sets the maximum number of read-only requests of the batches served concurrently
+# (0=the requests are served sequentially).
+# The batch settings apply to the batches of all the connections, the WebSocket and IPC ones included.
+batch-parallel-workers = {{ .JSONRPC.BatchParallelWorkers }}
+
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# IndexerBackend defines the storage backend of the custom transaction indexer.
+# Valid values are "kv" (same db backend as the app) and "sql" (embedded SQLite db).
+# The "sql" backend also serves eth_getTransactionsBySender, eth_getTransactionsByRecipient
+# and eth_getContractCreationTransaction.
+indexer-backend = "{{ .JSONRPC.IndexerBackend }}"
+
+# EnableLogIndexer enables the indexing of the addresses and first topics of the eth logs by the
+# custom transaction indexer, which is used by eth_getLogs instead of scanning every block.
+# It's only supported by the "kv" indexer backend.
+enable-log-indexer = {{ .JSONRPC.EnableLogIndexer }}
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
@@ -44,21 +44,43 @@ const (
 
 // JSON-RPC flags
 const (
-	JSONRPCEnable              = "json-rpc.enable"
-	JSONRPCAPI                 = "json-rpc.api"
-	JSONRPCAddress             = "json-rpc.address"
-	JSONWsAddress              = "json-rpc.ws-address"
-	JSONRPCGasCap              = "json-rpc.gas-cap"
-	JSONRPCEVMTimeout          = "json-rpc.evm-timeout"
-	JSONRPCTxFeeCap            = "json-rpc.txfee-cap"
-	JSONRPCFilterCap           = "json-rpc.filter-cap"
-	JSONRPCLogsCap             = "json-rpc.logs-cap"
-	JSONRPCBlockRangeCap       = "json-rpc.block-range-cap"
-	JSONRPCHTTPTimeout         = "json-rpc.http-timeout"
-	JSONRPCHTTPIdleTimeout     = "json-rpc.http-idle-timeout"
-	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
-	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
-	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCEnable                   = "json-rpc.enable"
+	JSONRPCAPI                      = "json-rpc.api"
+	JSONRPCAddress                  = "json-rpc.address"
+	JSONWsAddress                   = "json-rpc.ws-address"
+	JSONRPCWSOnHTTP                 = "json-rpc.ws-on-http"
+	JSONRPCWSOrigins                = "json-rpc.ws-origins"
+	JSONRPCIPCPath                  = "json-rpc.ipc-path"
+	JSONRPCGasCap                   = "json-rpc.gas-cap"
+	JSONRPCEVMTimeout               = "json-rpc.evm-timeout"
+	JSONRPCTxFeeCap                 = "json-rpc.txfee-cap"
+	JSONRPCFilterCap                = "json-rpc.filter-cap"
+	JSONRPCLogsCap                  = "json-rpc.logs-cap"
+	JSONRPCBlockRangeCap            = "json-rpc.block-range-cap"
+	JSONRPCTraceFilterBlockRangeCap = "json-rpc.trace-filter-block-range-cap"
+	JSONRPCHTTPTimeout              = "json-rpc.http-timeout"
+	JSONRPCHTTPIdleTimeout          = "json-rpc.http-idle-timeout"
+	JSONRPCAllowUnprotectedTxs      = "json-rpc.allow-unprotected-txs"
+	JSONRPCMaxOpenConnections       = "json-rpc.max-open-connections"
+	JSONRPCWSMaxSubscriptions       = "json-rpc.ws-max-subscriptions"
+	JSONRPCWSMaxMessageSize         = "json-rpc.ws-max-message-size"
+	JSONRPCWSMaxOutboundQueue       = "json-rpc.ws-max-outbound-queue"
+	JSONRPCWSSlowConsumerTimeout    = "json-rpc.ws-slow-consumer-timeout"
+	JSONRPCRateLimitEnable          = "json-rpc.rate-limit-enable"
+	JSONRPCRateLimitPerIP           = "json-rpc.rate-limit-per-ip"
+	JSONRPCRateLimitBurst           = "json-rpc.rate-limit-burst"
+	JSONRPCRateLimitMethods         = "json-rpc.rate-limit-methods"
+	JSONRPCRateLimitAPIKeys         = "json-rpc.rate-limit-api-keys"
+	JSONRPCAllowedMethods           = "json-rpc.allowed-methods"
+	JSONRPCDeniedMethods            = "json-rpc.denied-methods"
+	JSONRPCAuthJWTSecret            = "json-rpc.auth-jwt-secret"
+	JSONRPCResponseCacheSize        = "json-rpc.response-cache-size"
+	JSONRPCBatchRequestLimit        = "json-rpc.batch-request-limit"
+	JSONRPCBatchResponseMaxSize     = "json-rpc.batch-response-max-size"
+	JSONRPCBatchParallelWorkers     = "json-rpc.batch-parallel-workers"
+	JSONRPCEnableIndexer            = "json-rpc.enable-indexer"
+	JSONRPCIndexerBackend           = "json-rpc.indexer-backend"
+	JSONRPCEnableLogIndexer         = "json-rpc.enable-log-indexer"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
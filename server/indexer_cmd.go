@@ -25,7 +25,7 @@ import (
 	tmstore "github.com/cometbft/cometbft/store"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
-	"github.com/evmos/ethermint/indexer"
+	"github.com/evmos/ethermint/server/config"
 )
 
 func NewIndexTxCmd() *cobra.Command {
@@ -55,12 +55,21 @@ func NewIndexTxCmd() *cobra.Command {
 			cfg := serverCtx.Config
 			home := cfg.RootDir
 			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			appCfg, err := config.GetConfig(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+			idxer, err := OpenEVMTxIndexer(
+				home,
+				appCfg.JSONRPC,
+				server.GetAppDBBackend(serverCtx.Viper),
+				logger.With("module", "evmindex"),
+				clientCtx,
+			)
 			if err != nil {
 				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
-			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
 
 			// open local tendermint db, because the local rpc won't be available.
 			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
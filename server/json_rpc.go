@@ -16,18 +16,27 @@
 package server
 
 import (
+	"errors"
+	"net"
 	"net/http"
+	"path/filepath"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
 	"github.com/rs/cors"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	ethlog "github.com/ethereum/go-ethereum/log"
+	ethmetrics "github.com/ethereum/go-ethereum/metrics"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/evmos/ethermint/rpc"
+	"github.com/evmos/ethermint/rpc/backend"
+	rpcmetrics "github.com/evmos/ethermint/rpc/metrics"
+	"github.com/evmos/ethermint/rpc/middleware"
+	"github.com/evmos/ethermint/rpc/ratelimit"
 
 	"github.com/evmos/ethermint/server/config"
 	ethermint "github.com/evmos/ethermint/types"
@@ -74,17 +83,39 @@ func StartJSONRPC(ctx *server.Context,
 		}
 	}
 
-	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	access, err := newAccessControl(ctx.Config.RootDir, config, apis)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	handlerWithCors := cors.Default()
-	if config.API.EnableUnsafeCORS {
-		handlerWithCors = cors.AllowAll()
+	var limiter *ratelimit.Limiter
+	if config.JSONRPC.RateLimitEnable {
+		if limiter, err = newRateLimiter(config); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// allocate separate WS connection to Tendermint
+	wsTmClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
+	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, wsTmClient, config, evmBackend, limiter, access)
+
+	// the subscriptions of the WebSocket server replace the ones of the filters
+	// API, so they're the same on the connections of the JSON-RPC server
+	subAPI := wsSrv.SubscriptionsAPI()
+	if err := rpcServer.RegisterName(subAPI.Namespace, subAPI.Service); err != nil {
+		ctx.Logger.Error(
+			"failed to register service in JSON RPC namespace",
+			"namespace", subAPI.Namespace,
+			"service", subAPI.Service,
+		)
+		return nil, nil, err
 	}
+	streamSrv := rpc.NewStreamServer(ctx.Logger, rpcServer, config, limiter, access)
 
 	httpSrv := &http.Server{
 		Addr:              config.JSONRPC.Address,
-		Handler:           handlerWithCors.Handler(r),
+		Handler:           newJSONRPCHandler(config, rpcServer, streamSrv, access, limiter, apis),
 		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
 		ReadTimeout:       config.JSONRPC.HTTPTimeout,
 		WriteTimeout:      config.JSONRPC.HTTPTimeout,
@@ -97,6 +128,28 @@ func StartJSONRPC(ctx *server.Context,
 		return nil, nil, err
 	}
 
+	if ipcPath := config.JSONRPC.IPCPath; ipcPath != "" {
+		if !filepath.IsAbs(ipcPath) {
+			ipcPath = filepath.Join(ctx.Config.RootDir, ipcPath)
+		}
+
+		ipcLn, err := ListenIPC(ipcPath)
+		if err != nil {
+			_ = ln.Close()
+			return nil, nil, err
+		}
+		httpSrv.RegisterOnShutdown(func() {
+			_ = ipcLn.Close()
+		})
+
+		go func() {
+			ctx.Logger.Info("Starting JSON-RPC IPC server", "path", ipcPath)
+			if err := streamSrv.ServeIPC(ipcLn); err != nil && !errors.Is(err, net.ErrClosed) {
+				ctx.Logger.Error("failed to serve JSON-RPC IPC server", "error", err.Error())
+			}
+		}()
+	}
+
 	errCh := make(chan error)
 	go func() {
 		ctx.Logger.Info("Starting JSON-RPC server", "address", config.JSONRPC.Address)
@@ -119,10 +172,99 @@ func StartJSONRPC(ctx *server.Context,
 	}
 
 	ctx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
-
-	// allocate separate WS connection to Tendermint
-	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
+
+// newJSONRPCHandler returns the handler of the JSON-RPC server address. If
+// ws-on-http is enabled, the WebSocket upgrades are served by the stream
+// server of the JSON-RPC server.
+func newJSONRPCHandler(
+	config *config.Config,
+	rpcServer *ethrpc.Server,
+	streamSrv http.Handler,
+	access *middleware.AccessControl,
+	limiter *ratelimit.Limiter,
+	apis []ethrpc.API,
+) http.Handler {
+	r := mux.NewRouter()
+	if config.JSONRPC.WSOnHTTP {
+		r.Handle("/", streamSrv).MatcherFunc(func(r *http.Request, _ *mux.RouteMatch) bool {
+			return websocket.IsWebSocketUpgrade(r)
+		})
+	}
+	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+
+	// innermost to split the batches once the whole batch is allowed
+	handler := middleware.BatchHandler(r, middleware.BatchConfig{
+		RequestLimit:    config.JSONRPC.BatchRequestLimit,
+		ResponseMaxSize: config.JSONRPC.BatchResponseMaxSize,
+		Workers:         config.JSONRPC.BatchParallelWorkers,
+	})
+	if access != nil {
+		handler = access.Handler(handler)
+	}
+	if limiter != nil {
+		handler = limiter.Handler(handler)
+	}
+
+	if ethmetrics.Enabled {
+		// outermost to record the latency and the errors seen by the clients
+		handler = rpcmetrics.Handler(handler, rpcmetrics.Methods(apis))
+	}
+
+	handlerWithCors := cors.Default()
+	if config.API.EnableUnsafeCORS {
+		handlerWithCors = cors.AllowAll()
+	}
+	return handlerWithCors.Handler(handler)
+}
+
+// newAccessControl creates the access control shared by the HTTP and WebSocket
+// servers, it returns nil if the methods aren't restricted.
+func newAccessControl(homeDir string, config *config.Config, apis []ethrpc.API) (*middleware.AccessControl, error) {
+	if len(config.JSONRPC.AllowedMethods) == 0 && len(config.JSONRPC.DeniedMethods) == 0 && config.JSONRPC.AuthJWTSecret == "" {
+		return nil, nil
+	}
+
+	var secret []byte
+	if path := config.JSONRPC.AuthJWTSecret; path != "" {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(homeDir, path)
+		}
+
+		var err error
+		if secret, err = middleware.LoadJWTSecret(path); err != nil {
+			return nil, err
+		}
+	}
+
+	var privateNamespaces []string
+	for _, api := range apis {
+		if !api.Public {
+			privateNamespaces = append(privateNamespaces, api.Namespace)
+		}
+	}
+
+	return middleware.NewAccessControl(config.JSONRPC.AllowedMethods, config.JSONRPC.DeniedMethods, privateNamespaces, secret), nil
+}
+
+// newRateLimiter creates the rate limiter shared by the HTTP and WebSocket servers.
+func newRateLimiter(config *config.Config) (*ratelimit.Limiter, error) {
+	methods, err := config.JSONRPC.MethodRateLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := config.JSONRPC.APIKeyRateLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	return ratelimit.NewLimiter(ratelimit.Config{
+		PerIP:   config.JSONRPC.RateLimitPerIP,
+		Burst:   config.JSONRPC.RateLimitBurst,
+		Methods: methods,
+		APIKeys: apiKeys,
+	}), nil
+}
@@ -0,0 +1,286 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+	"github.com/cosmos/cosmos-sdk/client"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/rpc"
+	"github.com/evmos/ethermint/rpc/middleware"
+	"github.com/evmos/ethermint/rpc/ratelimit"
+	"github.com/evmos/ethermint/server/config"
+)
+
+// echoService is the JSON-RPC service of the test servers
+type echoService struct{}
+
+func (echoService) Echo(msg string) string {
+	return msg
+}
+
+// testAPIs serve the echo service in public and private namespaces
+var testAPIs = []ethrpc.API{
+	{Namespace: "test", Service: echoService{}, Public: true},
+	{Namespace: "debug", Service: echoService{}, Public: true},
+	{Namespace: "personal", Service: echoService{}},
+}
+
+// newTestStreamServer returns the stream server of a JSON-RPC server serving
+// the echo service and the subscriptions of the WebSocket server.
+func newTestStreamServer(t *testing.T, cfg *config.Config, access *middleware.AccessControl, limiter *ratelimit.Limiter) (*ethrpc.Server, *rpc.StreamServer) {
+	rpcServer := ethrpc.NewServer()
+	for _, api := range testAPIs {
+		require.NoError(t, rpcServer.RegisterName(api.Namespace, api.Service))
+	}
+
+	// the node events aren't used, the client isn't started
+	tmWSClient, err := rpcclient.NewWS("tcp://127.0.0.1:0", "/websocket")
+	require.NoError(t, err)
+	streamSrv := rpc.NewWebsocketsServer(client.Context{}, log.NewNopLogger(), tmWSClient, cfg, nil, limiter, access)
+	subAPI := streamSrv.SubscriptionsAPI()
+	require.NoError(t, rpcServer.RegisterName(subAPI.Namespace, subAPI.Service))
+
+	return rpcServer, rpc.NewStreamServer(log.NewNopLogger(), rpcServer, cfg, limiter, access)
+}
+
+// startTestJSONRPC serves the echo service on the handler of the JSON-RPC
+// address and returns the address and the stream server.
+func startTestJSONRPC(t *testing.T, cfg *config.Config, access *middleware.AccessControl, limiter *ratelimit.Limiter) (string, *rpc.StreamServer) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	cfg.JSONRPC.Address = ln.Addr().String()
+
+	rpcServer, streamSrv := newTestStreamServer(t, cfg, access, limiter)
+	srv := &httptest.Server{
+		Listener: ln,
+		Config:   &http.Server{Handler: newJSONRPCHandler(cfg, rpcServer, streamSrv, access, limiter, testAPIs)}, //nolint:gosec
+	}
+	srv.Start()
+	t.Cleanup(srv.Close)
+	return ln.Addr().String(), streamSrv
+}
+
+// dialTestIPC serves the IPC connections of the stream server and returns a
+// client connection.
+func dialTestIPC(t *testing.T, streamSrv *rpc.StreamServer) net.Conn {
+	path := filepath.Join(t.TempDir(), "rpc.ipc")
+	ln, err := ListenIPC(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+	go func() { _ = streamSrv.ServeIPC(ln) }()
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+// rpcResponse is the response of the test calls
+type rpcResponse struct {
+	ID     int    `json:"id"`
+	Result string `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func callWS(t *testing.T, conn *websocket.Conn, id int, method string) rpcResponse {
+	require.NoError(t, conn.WriteJSON(map[string]interface{}{"jsonrpc": "2.0", "id": id, "method": method, "params": []string{"hello"}}))
+
+	var res rpcResponse
+	require.NoError(t, conn.ReadJSON(&res))
+	return res
+}
+
+func callIPC(t *testing.T, conn net.Conn, reader *bufio.Reader, id int, method string) rpcResponse {
+	require.NoError(t, json.NewEncoder(conn).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": id, "method": method, "params": []string{"hello"}}))
+
+	line, err := reader.ReadBytes('\n')
+	require.NoError(t, err)
+	var res rpcResponse
+	require.NoError(t, json.Unmarshal(line, &res))
+	return res
+}
+
+func TestJSONRPCWebsocketOnHTTP(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.JSONRPC.WSOnHTTP = true
+	cfg.JSONRPC.WSOrigins = []string{"https://app.example.com"}
+	addr, _ := startTestJSONRPC(t, cfg, nil, nil)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+addr, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	res := callWS(t, conn, 1, "test_echo")
+	require.Nil(t, res.Error)
+	require.Equal(t, 1, res.ID)
+	require.Equal(t, "hello", res.Result)
+
+	// the upgrades are rejected from the origins which aren't allowed
+	_, resp, err := websocket.DefaultDialer.Dial("ws://"+addr, http.Header{"Origin": {"https://evil.example.com"}})
+	require.Error(t, err)
+	require.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	allowed, _, err := websocket.DefaultDialer.Dial("ws://"+addr, http.Header{"Origin": {"https://app.example.com"}})
+	require.NoError(t, err)
+	allowed.Close()
+}
+
+func TestJSONRPCWebsocketOnHTTPDisabled(t *testing.T) {
+	addr, _ := startTestJSONRPC(t, config.DefaultConfig(), nil, nil)
+
+	_, _, err := websocket.DefaultDialer.Dial("ws://"+addr, nil)
+	require.Error(t, err)
+}
+
+func TestJSONRPCIPC(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.JSONRPC.WSMaxMessageSize = 128
+	_, streamSrv := startTestJSONRPC(t, cfg, nil, nil)
+	conn := dialTestIPC(t, streamSrv)
+	reader := bufio.NewReader(conn)
+
+	for id := 1; id <= 2; id++ {
+		res := callIPC(t, conn, reader, id, "test_echo")
+		require.Nil(t, res.Error)
+		require.Equal(t, id, res.ID)
+		require.Equal(t, "hello", res.Result)
+	}
+
+	// the connection is dropped on a message larger than the max size
+	_, err := conn.Write([]byte(`{"jsonrpc":"2.0","id":3,"method":"test_echo","params":["` + strings.Repeat("a", 256) + `"]}`))
+	require.NoError(t, err)
+	_, err = reader.ReadBytes('\n')
+	require.Error(t, err)
+}
+
+func TestJSONRPCIPCWithoutHTTP(t *testing.T) {
+	// the IPC connections are served by the JSON-RPC server, not forwarded
+	// to its HTTP address
+	_, streamSrv := newTestStreamServer(t, config.DefaultConfig(), nil, nil)
+	conn := dialTestIPC(t, streamSrv)
+	reader := bufio.NewReader(conn)
+
+	res := callIPC(t, conn, reader, 1, "test_echo")
+	require.Nil(t, res.Error)
+	require.Equal(t, "hello", res.Result)
+
+	// eth_subscribe is the one of the WebSocket server
+	require.NoError(t, json.NewEncoder(conn).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": 2, "method": "eth_subscribe", "params": []string{"logs", "invalid"}}))
+	line, err := reader.ReadBytes('\n')
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(line, &res))
+	require.NotNil(t, res.Error)
+	require.Equal(t, -32602, res.Error.Code)
+	require.Contains(t, res.Error.Message, "invalid criteria")
+}
+
+func TestJSONRPCBatchLimitConnections(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.JSONRPC.BatchRequestLimit = 1
+	_, streamSrv := newTestStreamServer(t, cfg, nil, nil)
+	conn := dialTestIPC(t, streamSrv)
+	reader := bufio.NewReader(conn)
+
+	batch := []map[string]interface{}{
+		{"jsonrpc": "2.0", "id": 1, "method": "test_echo", "params": []string{"hello"}},
+		{"jsonrpc": "2.0", "id": 2, "method": "test_echo", "params": []string{"hello"}},
+	}
+	require.NoError(t, json.NewEncoder(conn).Encode(batch))
+	line, err := reader.ReadBytes('\n')
+	require.NoError(t, err)
+	var res []rpcResponse
+	require.NoError(t, json.Unmarshal(line, &res))
+	require.Len(t, res, 2)
+	for _, r := range res {
+		require.NotNil(t, r.Error)
+		require.Equal(t, middleware.ErrCodeInvalidRequest, r.Error.Code)
+	}
+
+	// the connection is still served
+	require.Nil(t, callIPC(t, conn, reader, 3, "test_echo").Error)
+}
+
+func TestJSONRPCRateLimitConnections(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.JSONRPC.WSOnHTTP = true
+	limiter := ratelimit.NewLimiter(ratelimit.Config{PerIP: 0.001, Burst: 3})
+	addr, streamSrv := startTestJSONRPC(t, cfg, nil, limiter)
+
+	// the upgrade takes a token, then each call does
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+addr, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	for id := 1; id <= 2; id++ {
+		require.Nil(t, callWS(t, conn, id, "test_echo").Error)
+	}
+	res := callWS(t, conn, 3, "test_echo")
+	require.NotNil(t, res.Error)
+	require.Equal(t, ratelimit.ErrCodeLimitExceeded, res.Error.Code)
+
+	// the IPC clients have their own bucket
+	ipcConn := dialTestIPC(t, streamSrv)
+	reader := bufio.NewReader(ipcConn)
+	for id := 1; id <= 3; id++ {
+		require.Nil(t, callIPC(t, ipcConn, reader, id, "test_echo").Error)
+	}
+	res = callIPC(t, ipcConn, reader, 4, "test_echo")
+	require.NotNil(t, res.Error)
+	require.Equal(t, ratelimit.ErrCodeLimitExceeded, res.Error.Code)
+}
+
+func TestJSONRPCAccessControlConnections(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.JSONRPC.WSOnHTTP = true
+	access := middleware.NewAccessControl(nil, []string{"debug_echo"}, []string{"personal"}, bytes.Repeat([]byte{1}, 32))
+	addr, streamSrv := startTestJSONRPC(t, cfg, access, nil)
+
+	// the methods are checked on each message of the connection
+	conn, _, err := websocket.DefaultDialer.Dial("ws://"+addr, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.Nil(t, callWS(t, conn, 1, "test_echo").Error)
+	res := callWS(t, conn, 2, "debug_echo")
+	require.NotNil(t, res.Error)
+	require.Equal(t, middleware.ErrCodeMethodNotFound, res.Error.Code)
+	res = callWS(t, conn, 3, "personal_echo")
+	require.NotNil(t, res.Error)
+	require.Equal(t, middleware.ErrCodeUnauthorized, res.Error.Code)
+
+	// the token is sent on upgrade
+	_, resp, err := websocket.DefaultDialer.Dial("ws://"+addr, http.Header{"Authorization": {"Bearer invalid"}})
+	require.Error(t, err)
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	authConn, _, err := websocket.DefaultDialer.Dial("ws://"+addr, http.Header{"Authorization": {"Bearer " + access.Token()}})
+	require.NoError(t, err)
+	defer authConn.Close()
+	require.Nil(t, callWS(t, authConn, 1, "personal_echo").Error)
+	res = callWS(t, authConn, 2, "debug_echo")
+	require.NotNil(t, res.Error)
+	require.Equal(t, middleware.ErrCodeMethodNotFound, res.Error.Code)
+
+	// the IPC clients are authenticated, the method lists still apply
+	ipcConn := dialTestIPC(t, streamSrv)
+	reader := bufio.NewReader(ipcConn)
+	require.Nil(t, callIPC(t, ipcConn, reader, 1, "personal_echo").Error)
+	res = callIPC(t, ipcConn, reader, 2, "debug_echo")
+	require.NotNil(t, res.Error)
+	require.Equal(t, middleware.ErrCodeMethodNotFound, res.Error.Code)
+}
@@ -38,6 +38,7 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 	abciserver "github.com/cometbft/cometbft/abci/server"
 	tcmd "github.com/cometbft/cometbft/cmd/cometbft/commands"
+	"github.com/cometbft/cometbft/libs/log"
 	tmos "github.com/cometbft/cometbft/libs/os"
 	"github.com/cometbft/cometbft/node"
 	"github.com/cometbft/cometbft/p2p"
@@ -204,6 +205,9 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().StringSlice(srvflags.JSONRPCAPI, config.GetDefaultAPINamespaces(), "Defines a list of JSON-RPC namespaces that should be enabled")
 	cmd.Flags().String(srvflags.JSONRPCAddress, config.DefaultJSONRPCAddress, "the JSON-RPC server address to listen on")
 	cmd.Flags().String(srvflags.JSONWsAddress, config.DefaultJSONRPCWsAddress, "the JSON-RPC WS server address to listen on")
+	cmd.Flags().Bool(srvflags.JSONRPCWSOnHTTP, false, "Enables the WebSocket connections on the JSON-RPC server address")
+	cmd.Flags().StringSlice(srvflags.JSONRPCWSOrigins, []string{"*"}, "Defines the origins the JSON-RPC WebSocket connections are accepted from (* for all)")
+	cmd.Flags().String(srvflags.JSONRPCIPCPath, "", "the JSON-RPC IPC socket path, relative to the node home directory unless absolute (disabled if empty)")
 	cmd.Flags().Uint64(srvflags.JSONRPCGasCap, config.DefaultGasCap, "Sets a cap on gas that can be used in eth_call/estimateGas unit is aphoton (0=infinite)")     //nolint:lll
 	cmd.Flags().Float64(srvflags.JSONRPCTxFeeCap, config.DefaultTxFeeCap, "Sets a cap on transaction fee that can be sent via the RPC APIs (1 = default 1 photon)") //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCFilterCap, config.DefaultFilterCap, "Sets the global cap for total number of filters that can be created")
@@ -213,8 +217,27 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(srvflags.JSONRPCAllowUnprotectedTxs, config.DefaultAllowUnprotectedTxs, "Allow for unprotected (non EIP155 signed) transactions to be submitted via the node's RPC when the global parameter is disabled") //nolint:lll
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, config.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
-	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
+	cmd.Flags().Int32(srvflags.JSONRPCTraceFilterBlockRangeCap, config.DefaultTraceFilterBlockRangeCap, "Sets the max block range allowed for `trace_filter` query, every block of the range is re-executed") //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener")                                      //nolint:lll
+	cmd.Flags().Int(srvflags.JSONRPCWSMaxSubscriptions, config.DefaultWSMaxSubscriptions, "Sets the maximum number of subscriptions of a WebSocket connection (0=unlimited)")
+	cmd.Flags().Int64(srvflags.JSONRPCWSMaxMessageSize, config.DefaultWSMaxMessageSize, "Sets the maximum size in bytes of a message received from a WebSocket connection (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCWSMaxOutboundQueue, config.DefaultWSMaxOutboundQueue, "Sets the maximum number of messages queued to be sent to a WebSocket connection (0=no queue)")
+	cmd.Flags().Duration(srvflags.JSONRPCWSSlowConsumerTimeout, config.DefaultWSSlowConsumerTimeout, "Sets the time a WebSocket connection has to read a message sent to it (0=infinite)")
+	cmd.Flags().Bool(srvflags.JSONRPCRateLimitEnable, false, "Enables the rate limiting of the JSON-RPC requests per client IP or API key")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimitPerIP, config.DefaultRateLimitPerIP, "Sets the number of requests per second allowed per client IP")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurst, config.DefaultRateLimitBurst, "Sets the number of requests a client can send at once")
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitMethods, config.DefaultRateLimitMethods, "Sets the requests per second allowed per client for the given methods, as method=rate entries")
+	cmd.Flags().StringSlice(srvflags.JSONRPCRateLimitAPIKeys, []string{}, "Sets the requests per second allowed for the given API keys, as key=rate entries")
+	cmd.Flags().StringSlice(srvflags.JSONRPCAllowedMethods, []string{}, "Restricts the JSON-RPC methods which can be called to the given ones (all if empty)")
+	cmd.Flags().StringSlice(srvflags.JSONRPCDeniedMethods, []string{}, "Defines the JSON-RPC methods which can't be called")
+	cmd.Flags().String(srvflags.JSONRPCAuthJWTSecret, "", "Defines the path of the JWT secret required by the non-public JSON-RPC namespaces")
+	cmd.Flags().Int(srvflags.JSONRPCResponseCacheSize, config.DefaultResponseCacheSize, "Sets the maximum number of historical JSON-RPC responses cached in memory (0=disabled)")
+	cmd.Flags().Int(srvflags.JSONRPCBatchRequestLimit, config.DefaultBatchRequestLimit, "Sets the maximum number of requests of a JSON-RPC batch (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCBatchResponseMaxSize, config.DefaultBatchResponseMaxSize, "Sets the maximum size in bytes of the responses of a JSON-RPC batch (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCBatchParallelWorkers, 0, "Sets the maximum number of read-only requests of the JSON-RPC batches served concurrently (0=sequentially)")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().String(srvflags.JSONRPCIndexerBackend, config.DefaultIndexerBackend, "Sets the storage backend of the custom tx indexer for json-rpc (kv|sql)")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndexer, false, "Enable the indexing of the eth log addresses and topics by the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
@@ -430,14 +453,13 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 
 	var idxer ethermint.EVMTxIndexer
 	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(ctx.Viper))
+		idxLogger := ctx.Logger.With("indexer", "evm")
+		idxer, err = OpenEVMTxIndexer(home, config.JSONRPC, server.GetAppDBBackend(ctx.Viper), idxLogger, clientCtx)
 		if err != nil {
 			logger.Error("failed to open evm indexer DB", "error", err.Error())
 			return err
 		}
 
-		idxLogger := ctx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
 		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(idxLogger)
 
@@ -653,6 +675,38 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenEVMTxIndexer opens the custom eth indexer with the configured indexer backend,
+// the kv backend uses the same db backend as the main app while the sql backend stores
+// the index in an embedded SQLite db.
+func OpenEVMTxIndexer(
+	rootDir string,
+	cfg config.JSONRPCConfig,
+	backendType dbm.BackendType,
+	logger log.Logger,
+	clientCtx client.Context,
+) (ethermint.EVMTxIndexer, error) {
+	switch cfg.IndexerBackend {
+	case config.IndexerBackendKV, "":
+		idxDB, err := OpenIndexerDB(rootDir, backendType)
+		if err != nil {
+			return nil, err
+		}
+		kvIndexer := indexer.NewKVIndexer(idxDB, logger, clientCtx)
+		if cfg.EnableLogIndexer {
+			kvIndexer.EnableLogIndex()
+		}
+		return kvIndexer, nil
+	case config.IndexerBackendSQL:
+		idxDB, err := indexer.OpenSQLIndexerDB(filepath.Join(rootDir, "data", "evmindexer.sqlite"))
+		if err != nil {
+			return nil, err
+		}
+		return indexer.NewSQLIndexer(idxDB, logger, clientCtx)
+	default:
+		return nil, fmt.Errorf("unknown evm indexer backend: %s", cfg.IndexerBackend)
+	}
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
@@ -18,6 +18,8 @@ package server
 import (
 	"net"
 	"net/http"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/evmos/ethermint/server/config"
@@ -138,3 +140,25 @@ func Listen(addr string, config *config.Config) (net.Listener, error) {
 	}
 	return ln, err
 }
+
+// ListenIPC starts a net.Listener on the unix socket at the given path. A stale
+// socket file left by a previous run is removed and the socket is only
+// accessible by the current user.
+func ListenIPC(path string) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		_ = ln.Close()
+		return nil, err
+	}
+	return ln, nil
+}
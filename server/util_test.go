@@ -0,0 +1,36 @@
+package server
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListenIPC(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "rpc.ipc")
+
+	ln, err := ListenIPC(path)
+	require.NoError(t, err)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.ModeSocket, info.Mode().Type())
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	// the socket file left by a previous run is removed
+	ln.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, ln.Close())
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+
+	ln, err = ListenIPC(path)
+	require.NoError(t, err)
+	require.NoError(t, ln.Close())
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package testutil
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"time"
+
+	"cosmossdk.io/simapp"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// PrecompileTestGas is the gas of the calls of PrecompileTest.
+const PrecompileTestGas = 10_000_000
+
+// PrecompileTest runs the precompiled contracts of the app on a StateDB, out
+// of any transaction. This should be used for testing purposes only!
+type PrecompileTest struct {
+	App     *app.EthermintApp
+	Ctx     sdk.Context
+	StateDB *statedb.StateDB
+	EVM     *vm.EVM
+}
+
+// NewPrecompileTest sets up an app with the precompiles at the given addresses
+// enabled and the EVM denom replaced if not empty.
+func NewPrecompileTest(evmDenom string, enabled ...common.Address) *PrecompileTest {
+	sort.Slice(enabled, func(i, j int) bool {
+		return bytes.Compare(enabled[i].Bytes(), enabled[j].Bytes()) < 0
+	})
+
+	ethApp := app.Setup(false, func(ethApp *app.EthermintApp, genesis simapp.GenesisState) simapp.GenesisState {
+		evmGenesis := evmtypes.DefaultGenesisState()
+		for _, addr := range enabled {
+			evmGenesis.Params.EnabledPrecompiles = append(evmGenesis.Params.EnabledPrecompiles, addr.Hex())
+		}
+		if evmDenom != "" {
+			evmGenesis.Params.EvmDenom = evmDenom
+		}
+		genesis[evmtypes.ModuleName] = ethApp.AppCodec().MustMarshalJSON(evmGenesis)
+		return genesis
+	})
+	ctx := ethApp.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "ethermint_9000-1",
+		Time:    time.Now().UTC(),
+	})
+
+	pt := &PrecompileTest{App: ethApp, Ctx: ctx}
+	pt.Reset()
+	return pt
+}
+
+// Reset replaces the StateDB and the EVM by new ones.
+func (pt *PrecompileTest) Reset() {
+	pt.StateDB = statedb.New(pt.Ctx, pt.App.EvmKeeper, statedb.NewEmptyTxConfig(common.Hash{}))
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		BlockNumber: big.NewInt(pt.Ctx.BlockHeight()),
+		Time:        big.NewInt(pt.Ctx.BlockTime().Unix()),
+		Difficulty:  big.NewInt(0),
+	}
+	chainConfig := evmtypes.DefaultChainConfig().EthereumConfig(big.NewInt(9000))
+	pt.EVM = vm.NewEVM(blockCtx, vm.TxContext{}, pt.StateDB, chainConfig, vm.Config{})
+}
+
+// Call calls the method of the contract at the address and returns its
+// unpacked outputs.
+func (pt *PrecompileTest) Call(
+	from, to common.Address,
+	contractABI abi.ABI,
+	method string,
+	args ...interface{},
+) ([]interface{}, error) {
+	input, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	ret, _, err := pt.EVM.Call(vm.AccountRef(from), to, input, PrecompileTestGas, big.NewInt(0))
+	if err != nil {
+		return nil, err
+	}
+	return contractABI.Unpack(method, ret)
+}
@@ -25,6 +25,8 @@ import (
 type EVMTxIndexer interface {
 	// LastIndexedBlock returns -1 if indexer db is empty
 	LastIndexedBlock() (int64, error)
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
 	IndexBlock(*tmtypes.Block, []*abci.ResponseDeliverTx) error
 
 	// GetByTxHash returns nil if tx not found.
@@ -32,3 +34,35 @@ type EVMTxIndexer interface {
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
 }
+
+// EVMLogIndexer defines the interface of a custom eth tx indexer which also indexes
+// the addresses and first topics of the logs emitted in every block.
+type EVMLogIndexer interface {
+	// LogIndexedRange returns the first and last block covered by the log index,
+	// returns -1 if the log index is empty.
+	LogIndexedRange() (first int64, last int64, err error)
+	// LogUnindexedBlocks returns the ascending heights of the blocks within [from, to]
+	// which are not covered by the log index, e.g. the blocks indexed while the log
+	// index was disabled.
+	LogUnindexedBlocks(from, to int64) ([]int64, error)
+	// GetLogBlocks returns the ascending heights of the blocks within [from, to] which
+	// contain logs emitted by one of the addresses and logs with one of the topics0 as
+	// first topic, an empty list matches any address or topic respectively. The logs
+	// of the returned blocks still have to be filtered as both conditions may be met
+	// by different logs.
+	GetLogBlocks(addresses []common.Address, topics0 []common.Hash, from, to int64) ([]int64, error)
+}
+
+// EVMTxQueryIndexer defines the interface of a custom eth tx indexer which also
+// indexes the senders, the recipients and the created contracts of the eth txs.
+type EVMTxQueryIndexer interface {
+	// GetBySender returns the hashes of the eth txs sent by the address, ordered
+	// by block and index, skipping the first offset ones and returning up to limit.
+	GetBySender(sender common.Address, offset, limit int) ([]common.Hash, error)
+	// GetByRecipient returns the hashes of the eth txs sent to the address, ordered
+	// by block and index, skipping the first offset ones and returning up to limit.
+	GetByRecipient(recipient common.Address, offset, limit int) ([]common.Hash, error)
+	// GetContractCreation returns the hash of the eth tx which created the contract
+	// at the address, returns an error if not found.
+	GetContractCreation(contract common.Address) (common.Hash, error)
+}
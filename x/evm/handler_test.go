@@ -563,6 +563,12 @@ func (suite *EvmTestSuite) TestERC20TransferReverted() {
 			&FailureHook{},
 			"failed to execute post processing",
 		},
+		{
+			"pre failure hooks",
+			1000000, // enough gas limit, but hooks fails before the execution.
+			&PreFailureHook{},
+			"failed to execute pre processing",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -693,15 +699,24 @@ func (suite *EvmTestSuite) TestContractDeploymentRevert() {
 }
 
 // DummyHook implements EvmHooks interface
-type DummyHook struct{}
-
-func (dh *DummyHook) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
-	return nil
+type DummyHook struct {
+	types.NoOpEvmHooks
 }
 
 // FailureHook implements EvmHooks interface
-type FailureHook struct{}
+type FailureHook struct {
+	types.NoOpEvmHooks
+}
 
 func (dh *FailureHook) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	return errors.New("mock error")
 }
+
+// PreFailureHook implements EvmHooks interface
+type PreFailureHook struct {
+	types.NoOpEvmHooks
+}
+
+func (dh *PreFailureHook) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	return errors.New("mock error")
+}
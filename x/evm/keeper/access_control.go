@@ -0,0 +1,115 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+var (
+	_ statedb.JournalEntry = deniedCreationChange{}
+
+	// revertSelector is the selector of the Error(string) revert data
+	revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+)
+
+// revertData returns the ABI encoded Error(string) revert data of the error.
+func revertData(err error) []byte {
+	reason := []byte(err.Error())
+	data := make([]byte, 0, len(revertSelector)+2*common.HashLength+len(reason)+common.HashLength)
+	data = append(data, revertSelector...)
+	data = append(data, common.BigToHash(big.NewInt(common.HashLength)).Bytes()...)
+	data = append(data, common.BigToHash(big.NewInt(int64(len(reason)))).Bytes()...)
+	return append(data, common.RightPadBytes(reason, (len(reason)+common.HashLength-1)/common.HashLength*common.HashLength)...)
+}
+
+// revertStub returns the code reverting with the revert data of the error: it
+// copies the revert data appended to the code to the memory and reverts with
+// it.
+func revertStub(err error) []byte {
+	data := revertData(err)
+	size := []byte{byte(len(data) >> 8), byte(len(data))}
+	code := []byte{byte(vm.PUSH2), size[0], size[1], byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CODECOPY)}
+	code = append(code, byte(vm.PUSH2), size[0], size[1], byte(vm.PUSH1), 0, byte(vm.REVERT))
+	// the code offset of the revert data
+	code[4] = byte(len(code))
+	return append(code, data...)
+}
+
+// blockedContractStubs returns the code run by the EVM instead of the code of
+// the blocked contracts of the params, reverting with ErrContractBlocked. The
+// calls to a blocked contract fail on their own with the revert data, the
+// caller can handle it.
+func blockedContractStubs(params types.Params) map[common.Address][]byte {
+	stubs := make(map[common.Address][]byte, len(params.BlockedContracts))
+	for _, hexAddr := range params.BlockedContracts {
+		addr := common.HexToAddress(hexAddr)
+		stubs[addr] = revertStub(errorsmod.Wrap(types.ErrContractBlocked, addr.Hex()))
+	}
+	return stubs
+}
+
+// deployerCheck records the contract creations of the deployers which aren't
+// allowed in the create path: the EVM transfers the endowment of a creation
+// from the deployer to the created contract right before running its init
+// code. The EVM has no hook to fail the creation alone with revert data, so a
+// creation which isn't reverted fails the whole message with the revert data
+// of ErrDeployerNotAllowed.
+type deployerCheck struct {
+	params  types.Params
+	stateDB *statedb.StateDB
+
+	// denied is the error of the first creation which isn't allowed, it's
+	// reset if the creation is reverted
+	denied error
+}
+
+func newDeployerCheck(params types.Params, stateDB *statedb.StateDB) *deployerCheck {
+	return &deployerCheck{params: params, stateDB: stateDB}
+}
+
+// transfer wraps the Transfer function of the EVM to record the creations of
+// the deployers which aren't allowed.
+func (c *deployerCheck) transfer(transfer vm.TransferFunc) vm.TransferFunc {
+	return func(db vm.StateDB, sender, recipient common.Address, amount *big.Int) {
+		if c.denied == nil && c.stateDB.IsCreatingContract(recipient) && !c.params.IsDeployerAllowed(sender) {
+			c.stateDB.AppendJournalEntry(deniedCreationChange{check: c})
+			c.denied = errorsmod.Wrap(types.ErrDeployerNotAllowed, sender.Hex())
+		}
+		transfer(db, sender, recipient, amount)
+	}
+}
+
+// deniedCreationChange resets the denied creation once it's reverted.
+type deniedCreationChange struct {
+	check *deployerCheck
+}
+
+func (ch deniedCreationChange) Revert(*statedb.StateDB) {
+	ch.check.denied = nil
+}
+
+func (ch deniedCreationChange) Dirtied() *common.Address {
+	return nil
+}
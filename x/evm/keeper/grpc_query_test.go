@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
@@ -402,6 +403,96 @@ func (suite *KeeperTestSuite) TestQueryTxLogs() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestPendingAccountState() {
+	var predecessors []*types.MsgEthereumTx
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	amount := big.NewInt(1000)
+
+	transferTx := func(nonce uint64) *types.MsgEthereumTx {
+		chainID := suite.app.EvmKeeper.ChainID()
+		gasPrice := suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx)
+		if gasPrice == nil {
+			gasPrice = big.NewInt(1)
+		}
+		tx := types.NewTx(chainID, nonce, &recipient, amount, ethparams.TxGas, gasPrice, nil, nil, nil, nil)
+		tx.From = suite.address.Hex()
+		suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+		return tx
+	}
+
+	testCases := []struct {
+		msg        string
+		malleate   func(nonce uint64)
+		expBalance *big.Int
+		expNonce   uint64
+	}{
+		{
+			"pass - no predecessors",
+			func(uint64) {
+				predecessors = nil
+			},
+			big.NewInt(0),
+			0,
+		},
+		{
+			"pass - transfers are applied in order",
+			func(nonce uint64) {
+				predecessors = []*types.MsgEthereumTx{transferTx(nonce), transferTx(nonce + 1)}
+			},
+			new(big.Int).Mul(amount, big.NewInt(2)),
+			2,
+		},
+		{
+			"pass - tx with invalid nonce is skipped",
+			func(nonce uint64) {
+				predecessors = []*types.MsgEthereumTx{transferTx(nonce), transferTx(nonce + 2)}
+			},
+			amount,
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			amt := sdk.Coins{ethermint.NewPhotonCoin(sdkmath.NewIntWithDecimal(1, 18))}
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, amt))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.address.Bytes(), amt))
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			tc.malleate(nonce)
+
+			res, err := suite.queryClient.PendingAccountState(sdk.WrapSDKContext(suite.ctx), &types.QueryPendingAccountStateRequest{
+				Address:         recipient.Hex(),
+				StorageKeys:     []string{common.Hash{}.Hex()},
+				Predecessors:    predecessors,
+				ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expBalance.String(), res.Balance)
+			suite.Require().Empty(res.Code)
+			suite.Require().Equal([]string{common.Hash{}.Hex()}, res.Storage)
+
+			senderRes, err := suite.queryClient.PendingAccountState(sdk.WrapSDKContext(suite.ctx), &types.QueryPendingAccountStateRequest{
+				Address:         suite.address.Hex(),
+				Predecessors:    predecessors,
+				ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal(nonce+tc.expNonce, senderRes.Nonce)
+
+			// the predecessors are not written to the state of the query
+			suite.Require().Equal(nonce, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+			res, err = suite.queryClient.PendingAccountState(sdk.WrapSDKContext(suite.ctx), &types.QueryPendingAccountStateRequest{
+				Address:         recipient.Hex(),
+				ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal("0", res.Balance)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.ctx)
 	expParams := types.DefaultParams()
@@ -754,6 +845,80 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestCreateAccessList() {
+	var args types.TransactionArgs
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+
+	testCases := []struct {
+		msg           string
+		malleate      func()
+		expPass       bool
+		expStorageLen int
+	}{
+		{
+			"pass - plain transfer has an empty access list",
+			func() {
+				args = types.TransactionArgs{From: &suite.address, To: &recipient}
+			},
+			true,
+			-1,
+		},
+		{
+			"pass - erc20 transfer accesses the balances of the contract",
+			func() {
+				contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+				suite.Commit()
+				transferData, err := types.ERC20Contract.ABI.Pack("transfer", recipient, big.NewInt(1000))
+				suite.Require().NoError(err)
+				args = types.TransactionArgs{From: &suite.address, To: &contractAddr, Data: (*hexutil.Bytes)(&transferData)}
+			},
+			true,
+			2,
+		},
+		{
+			"fail - gas too low",
+			func() {
+				gas := hexutil.Uint64(100)
+				args = types.TransactionArgs{From: &suite.address, To: &recipient, Gas: &gas}
+			},
+			false,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			argsBz, err := json.Marshal(&args)
+			suite.Require().NoError(err)
+			req := &types.EthCallRequest{
+				Args:            argsBz,
+				GasCap:          uint64(config.DefaultGasCap),
+				ProposerAddress: suite.ctx.BlockHeader().ProposerAddress,
+			}
+
+			res, err := suite.queryClient.CreateAccessList(sdk.WrapSDKContext(suite.ctx), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Require().GreaterOrEqual(res.GasUsed, ethparams.TxGas)
+			if tc.expStorageLen < 0 {
+				suite.Require().Empty(res.AccessList)
+				return
+			}
+			suite.Require().Len(res.AccessList, 1)
+			suite.Require().Equal(args.To.Hex(), res.AccessList[0].Address)
+			suite.Require().Len(res.AccessList[0].StorageKeys, tc.expStorageLen)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestTraceTx() {
 	// TODO deploy contract that triggers internal transactions
 	var (
@@ -982,6 +1147,151 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceTxNativeTracers() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	txMsg := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, sdkmath.NewIntWithDecimal(1, 18).BigInt())
+	suite.Commit()
+
+	type callFrame struct {
+		Type string         `json:"type"`
+		From common.Address `json:"from"`
+		To   common.Address `json:"to"`
+		Gas  hexutil.Uint64 `json:"gas"`
+		Logs []struct {
+			Address common.Address `json:"address"`
+			Topics  []common.Hash  `json:"topics"`
+		} `json:"logs"`
+	}
+	type account struct {
+		Nonce   uint64                      `json:"nonce"`
+		Storage map[common.Hash]common.Hash `json:"storage"`
+	}
+
+	testCases := []struct {
+		msg          string
+		tracer       string
+		tracerConfig string
+		expPass      bool
+		checkResult  func(data []byte)
+	}{
+		{
+			"call tracer with logs",
+			"callTracer",
+			`{"withLog":true}`,
+			true,
+			func(data []byte) {
+				var frame callFrame
+				suite.Require().NoError(json.Unmarshal(data, &frame))
+				suite.Require().Equal("CALL", frame.Type)
+				suite.Require().Equal(suite.address, frame.From)
+				suite.Require().Equal(contractAddr, frame.To)
+				suite.Require().Equal(txMsg.GetGas(), uint64(frame.Gas))
+				suite.Require().Len(frame.Logs, 1)
+				suite.Require().Equal(contractAddr, frame.Logs[0].Address)
+				suite.Require().Equal(types.ERC20Contract.ABI.Events["Transfer"].ID, frame.Logs[0].Topics[0])
+			},
+		},
+		{
+			"call tracer only top call",
+			"callTracer",
+			`{"onlyTopCall":true}`,
+			true,
+			func(data []byte) {
+				var frame callFrame
+				suite.Require().NoError(json.Unmarshal(data, &frame))
+				suite.Require().Equal(contractAddr, frame.To)
+				suite.Require().Empty(frame.Logs)
+			},
+		},
+		{
+			"prestate tracer",
+			"prestateTracer",
+			"",
+			true,
+			func(data []byte) {
+				var prestate map[common.Address]account
+				suite.Require().NoError(json.Unmarshal(data, &prestate))
+				suite.Require().Contains(prestate, suite.address)
+				suite.Require().Contains(prestate, contractAddr)
+				suite.Require().Len(prestate[contractAddr].Storage, 2)
+			},
+		},
+		{
+			"prestate tracer in diff mode",
+			"prestateTracer",
+			`{"diffMode":true}`,
+			true,
+			func(data []byte) {
+				var diff struct {
+					Pre  map[common.Address]account `json:"pre"`
+					Post map[common.Address]account `json:"post"`
+				}
+				suite.Require().NoError(json.Unmarshal(data, &diff))
+				suite.Require().Equal(diff.Pre[suite.address].Nonce+1, diff.Post[suite.address].Nonce)
+				suite.Require().Len(diff.Pre[contractAddr].Storage, 2)
+				suite.Require().Len(diff.Post[contractAddr].Storage, 2)
+			},
+		},
+		{
+			"4byte tracer",
+			"4byteTracer",
+			"",
+			true,
+			func(data []byte) {
+				suite.Require().JSONEq(`{"0xa9059cbb-64":1}`, string(data))
+			},
+		},
+		{
+			"invalid tracer config",
+			"callTracer",
+			`{"onlyTopCall":"yes"}`,
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			traceConfig := &types.TraceConfig{
+				Tracer:           tc.tracer,
+				TracerJsonConfig: tc.tracerConfig,
+			}
+			res, err := suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+				Msg:         txMsg,
+				TraceConfig: traceConfig,
+			})
+			if tc.expPass {
+				suite.Require().NoError(err)
+				tc.checkResult(res.Data)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			// the block trace applies the same tracer config
+			blockRes, err := suite.queryClient.TraceBlock(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceBlockRequest{
+				Txs:         []*types.MsgEthereumTx{txMsg},
+				TraceConfig: traceConfig,
+			})
+			suite.Require().NoError(err)
+			var results []struct {
+				Result json.RawMessage `json:"result"`
+				Error  string          `json:"error"`
+			}
+			suite.Require().NoError(json.Unmarshal(blockRes.Data, &results))
+			suite.Require().Len(results, 1)
+			if tc.expPass {
+				suite.Require().Empty(results[0].Error)
+				tc.checkResult(results[0].Result)
+			} else {
+				suite.Require().NotEmpty(results[0].Error)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestTraceBlock() {
 	var (
 		txs         []*types.MsgEthereumTx
@@ -1098,6 +1408,16 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 			expPass:       true,
 			traceResponse: "[{\"error\":\"rpc error: code = Internal desc = tracer not found\"}]",
 		},
+		{
+			msg: "native tracer",
+			malleate: func() {
+				traceConfig = &types.TraceConfig{
+					Tracer: "4byteTracer",
+				}
+			},
+			expPass:       true,
+			traceResponse: "[{\"result\":{\"0xa9059cbb-64\":1}}]",
+		},
 		{
 			msg: "invalid chain id",
 			malleate: func() {
@@ -1155,6 +1475,110 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceCall() {
+	var (
+		args        types.TransactionArgs
+		traceConfig *types.TraceConfig
+		overrides   types.StateOverride
+	)
+
+	contract := tests.GenerateAddress()
+	slot := common.BigToHash(big.NewInt(0))
+	value := common.BigToHash(big.NewInt(42))
+	// PUSH1 0 SLOAD PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	sloadCode := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+	gas := hexutil.Uint64(100000)
+
+	testCases := []struct {
+		msg           string
+		malleate      func()
+		expPass       bool
+		traceResponse string
+	}{
+		{
+			"default trace",
+			func() {
+				args = types.TransactionArgs{From: &suite.address, To: &contract, Gas: &gas}
+			},
+			true,
+			"{\"gas\":50000,\"failed\":false,\"returnValue\":\"\",\"structLogs\":[]}",
+		},
+		{
+			"default trace with state overrides",
+			func() {
+				args = types.TransactionArgs{From: &suite.address, To: &contract, Gas: &gas}
+				traceConfig = &types.TraceConfig{DisableStack: true, DisableStorage: true}
+				overrides = types.StateOverride{contract: types.OverrideAccount{
+					Code:  &sloadCode,
+					State: &map[common.Hash]common.Hash{slot: value},
+				}}
+			},
+			true,
+			"{\"gas\":50000,\"failed\":false,\"returnValue\":\"" + common.Bytes2Hex(value.Bytes()) + "\",\"structLogs\":[{\"pc\":0,\"op\":\"PUSH1\"",
+		},
+		{
+			"javascript tracer",
+			func() {
+				args = types.TransactionArgs{From: &suite.address, To: &contract, Gas: &gas}
+				traceConfig = &types.TraceConfig{
+					Tracer: "{data: [], fault: function(log) {}, step: function(log) { this.data.push(log.op.toString()); }, result: function() { return this.data; }}",
+				}
+				overrides = types.StateOverride{contract: types.OverrideAccount{Code: &sloadCode}}
+			},
+			true,
+			"[\"PUSH1\",\"SLOAD\",\"PUSH1\",\"MSTORE\",\"PUSH1\",\"PUSH1\",\"RETURN\"]",
+		},
+		{
+			"invalid trace config - Negative Limit",
+			func() {
+				args = types.TransactionArgs{From: &suite.address, To: &contract, Gas: &gas}
+				traceConfig = &types.TraceConfig{Limit: -1}
+			},
+			false,
+			"",
+		},
+		{
+			"invalid trace config - Invalid Tracer",
+			func() {
+				args = types.TransactionArgs{From: &suite.address, To: &contract, Gas: &gas}
+				traceConfig = &types.TraceConfig{Tracer: "invalid_tracer"}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			traceConfig = nil
+			overrides = nil
+			tc.malleate()
+
+			argsBz, err := json.Marshal(&args)
+			suite.Require().NoError(err)
+			req := &types.QueryTraceCallRequest{
+				Args:        argsBz,
+				GasCap:      uint64(config.DefaultGasCap),
+				TraceConfig: traceConfig,
+			}
+			if overrides != nil {
+				req.Overrides, err = json.Marshal(overrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.queryClient.TraceCall(suite.ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().LessOrEqual(len(tc.traceResponse), len(res.Data))
+				suite.Require().Equal(tc.traceResponse, string(res.Data[:len(tc.traceResponse)]))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestNonceInQuery() {
 	address := tests.GenerateAddress()
 	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetNonce(suite.ctx, address))
@@ -1336,6 +1760,139 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEthCallOverrides() {
+	var (
+		args           types.TransactionArgs
+		overrides      types.StateOverride
+		blockOverrides *types.BlockOverrides
+	)
+
+	contract := tests.GenerateAddress()
+	slot := common.BigToHash(big.NewInt(0))
+	value := common.BigToHash(big.NewInt(42))
+	// NUMBER PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	numberCode := hexutil.Bytes(common.FromHex("0x4360005260206000f3"))
+	// PUSH1 0 SLOAD PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	sloadCode := hexutil.Bytes(common.FromHex("0x60005460005260206000f3"))
+	balance := (*hexutil.Big)(big.NewInt(1000))
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+		expRet   []byte
+	}{
+		{
+			"fail - not enough balance without overrides",
+			func() {
+				from := tests.GenerateAddress()
+				args = types.TransactionArgs{From: &from, To: &contract, Value: (*hexutil.Big)(big.NewInt(100))}
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - balance override",
+			func() {
+				from := tests.GenerateAddress()
+				args = types.TransactionArgs{From: &from, To: &contract, Value: (*hexutil.Big)(big.NewInt(100))}
+				overrides = types.StateOverride{from: types.OverrideAccount{Balance: &balance}}
+			},
+			true,
+			nil,
+		},
+		{
+			"pass - code override with block number override",
+			func() {
+				args = types.TransactionArgs{To: &contract}
+				overrides = types.StateOverride{contract: types.OverrideAccount{Code: &numberCode}}
+				blockOverrides = &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(0x1234))}
+			},
+			true,
+			common.BigToHash(big.NewInt(0x1234)).Bytes(),
+		},
+		{
+			"pass - state override",
+			func() {
+				args = types.TransactionArgs{To: &contract}
+				overrides = types.StateOverride{contract: types.OverrideAccount{
+					Code:  &sloadCode,
+					State: &map[common.Hash]common.Hash{slot: value},
+				}}
+			},
+			true,
+			value.Bytes(),
+		},
+		{
+			"pass - state diff override",
+			func() {
+				args = types.TransactionArgs{To: &contract}
+				overrides = types.StateOverride{contract: types.OverrideAccount{
+					Code:      &sloadCode,
+					StateDiff: &map[common.Hash]common.Hash{slot: value},
+				}}
+			},
+			true,
+			value.Bytes(),
+		},
+		{
+			"fail - both state and state diff",
+			func() {
+				args = types.TransactionArgs{To: &contract}
+				overrides = types.StateOverride{contract: types.OverrideAccount{
+					State:     &map[common.Hash]common.Hash{},
+					StateDiff: &map[common.Hash]common.Hash{},
+				}}
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			overrides = nil
+			blockOverrides = nil
+			tc.malleate()
+
+			argsBz, err := json.Marshal(&args)
+			suite.Require().NoError(err)
+			req := &types.EthCallRequest{Args: argsBz, GasCap: uint64(config.DefaultGasCap)}
+			if overrides != nil {
+				req.Overrides, err = json.Marshal(overrides)
+				suite.Require().NoError(err)
+			}
+			if blockOverrides != nil {
+				req.BlockOverrides, err = json.Marshal(blockOverrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.queryClient.EthCall(suite.ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Empty(res.VmError)
+				suite.Require().Equal(tc.expRet, res.Ret)
+
+				// the overrides must not be persisted
+				suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, crypto.Keccak256Hash(numberCode)))
+			} else {
+				if err == nil {
+					suite.Require().NotEmpty(res.VmError)
+				}
+			}
+
+			estimateRes, err := suite.queryClient.EstimateGas(suite.ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().GreaterOrEqual(estimateRes.Gas, ethparams.TxGas)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	k := suite.app.EvmKeeper
 
@@ -1379,6 +1936,12 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.Code(suite.ctx, nil)
 			},
 		},
+		{
+			"PendingAccountState method",
+			func() (interface{}, error) {
+				return k.PendingAccountState(suite.ctx, nil)
+			},
+		},
 		{
 			"EthCall method",
 			func() (interface{}, error) {
@@ -1403,6 +1966,18 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.TraceBlock(suite.ctx, nil)
 			},
 		},
+		{
+			"CreateAccessList method",
+			func() (interface{}, error) {
+				return k.CreateAccessList(suite.ctx, nil)
+			},
+		},
+		{
+			"TraceCall method",
+			func() (interface{}, error) {
+				return k.TraceCall(suite.ctx, nil)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
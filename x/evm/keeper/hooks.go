@@ -16,14 +16,22 @@
 package keeper
 
 import (
+	"math/big"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
-var _ types.EvmHooks = MultiEvmHooks{}
+var (
+	_ types.EvmHooks     = MultiEvmHooks{}
+	_ types.EvmCallHooks = MultiEvmCallHooks{}
+	_ vm.EVMLogger       = &callHooksTracer{}
+)
 
 // MultiEvmHooks combine multiple evm hooks, all hook functions are run in array sequence
 type MultiEvmHooks []types.EvmHooks
@@ -33,6 +41,16 @@ func NewMultiEvmHooks(hooks ...types.EvmHooks) MultiEvmHooks {
 	return hooks
 }
 
+// PreTxProcessing delegate the call to underlying hooks
+func (mh MultiEvmHooks) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	for i := range mh {
+		if err := mh[i].PreTxProcessing(ctx, msg); err != nil {
+			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
+
 // PostTxProcessing delegate the call to underlying hooks
 func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	for i := range mh {
@@ -42,3 +60,98 @@ func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, rece
 	}
 	return nil
 }
+
+// MultiEvmCallHooks combine multiple evm call hooks, all hook functions are run in array sequence
+type MultiEvmCallHooks []types.EvmCallHooks
+
+// NewMultiEvmCallHooks combine multiple evm call hooks
+func NewMultiEvmCallHooks(hooks ...types.EvmCallHooks) MultiEvmCallHooks {
+	return hooks
+}
+
+// CallEnter delegate the call to underlying hooks
+func (mh MultiEvmCallHooks) CallEnter(
+	ctx sdk.Context,
+	typ vm.OpCode,
+	from, to common.Address,
+	input []byte,
+	gas uint64,
+	value *big.Int,
+) error {
+	for i := range mh {
+		if err := mh[i].CallEnter(ctx, typ, from, to, input, gas, value); err != nil {
+			return errorsmod.Wrapf(err, "EVM call hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
+
+// CallExit delegate the call to underlying hooks
+func (mh MultiEvmCallHooks) CallExit(ctx sdk.Context, output []byte, gasUsed uint64, err error) error {
+	for i := range mh {
+		if hookErr := mh[i].CallExit(ctx, output, gasUsed, err); hookErr != nil {
+			return errorsmod.Wrapf(hookErr, "EVM call hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
+
+// callHooksTracer runs the call hooks on the internal calls captured by the
+// EVM, and forwards all the events to the wrapped tracer. The hooks can't stop
+// the execution, the first error they return is kept to revert the tx once
+// executed, and no hook is run after it.
+//
+// Each call has its own branch of the context of its caller: CallEnter and the
+// hooks of the nested calls write to it, and it's only written to the context
+// of the caller if the call succeeds. CallExit is then run on the context of
+// the caller, so the hooks can record the reverted calls.
+type callHooksTracer struct {
+	vm.EVMLogger
+
+	ctx   sdk.Context
+	hooks types.EvmCallHooks
+	err   error
+
+	// the branches of the calls being executed, the innermost last
+	frames []callHooksFrame
+}
+
+// callHooksFrame is the branch of the context of a call.
+type callHooksFrame struct {
+	parent sdk.Context
+	write  func()
+}
+
+func newCallHooksTracer(ctx sdk.Context, hooks types.EvmCallHooks, tracer vm.EVMLogger) *callHooksTracer {
+	return &callHooksTracer{EVMLogger: tracer, ctx: ctx, hooks: hooks}
+}
+
+// CaptureEnter implements vm.EVMLogger
+func (t *callHooksTracer) CaptureEnter(typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) {
+	parent := t.ctx
+	ctx, write := parent.CacheContext()
+	t.frames = append(t.frames, callHooksFrame{parent: parent, write: write})
+	t.ctx = ctx
+
+	if t.err == nil {
+		t.err = t.hooks.CallEnter(t.ctx, typ, from, to, input, gas, value)
+	}
+	t.EVMLogger.CaptureEnter(typ, from, to, input, gas, value)
+}
+
+// CaptureExit implements vm.EVMLogger
+func (t *callHooksTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if len(t.frames) > 0 {
+		frame := t.frames[len(t.frames)-1]
+		t.frames = t.frames[:len(t.frames)-1]
+		if err == nil {
+			frame.write()
+		}
+		t.ctx = frame.parent
+	}
+
+	if t.err == nil {
+		t.err = t.hooks.CallExit(t.ctx, output, gasUsed, err)
+	}
+	t.EVMLogger.CaptureExit(output, gasUsed, err)
+}
@@ -1,14 +1,18 @@
 package keeper_test
 
 import (
+	"bytes"
 	"errors"
 	"math/big"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 
+	"github.com/evmos/ethermint/tests"
 	"github.com/evmos/ethermint/x/evm/keeper"
 	"github.com/evmos/ethermint/x/evm/statedb"
 	"github.com/evmos/ethermint/x/evm/types"
@@ -16,6 +20,7 @@ import (
 
 // LogRecordHook records all the logs
 type LogRecordHook struct {
+	types.NoOpEvmHooks
 	Logs []*ethtypes.Log
 }
 
@@ -27,10 +32,56 @@ func (dh *LogRecordHook) PostTxProcessing(ctx sdk.Context, msg core.Message, rec
 // FailureHook always fail
 type FailureHook struct{}
 
+func (dh FailureHook) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	return errors.New("pre tx processing failed")
+}
+
 func (dh FailureHook) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	return errors.New("post tx processing failed")
 }
 
+// CallRecordHook counts the internal calls
+type CallRecordHook struct {
+	Enter, Exit int
+	Fail        bool
+}
+
+func (dh *CallRecordHook) CallEnter(ctx sdk.Context, typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) error {
+	dh.Enter++
+	if dh.Fail {
+		return errors.New("call processing failed")
+	}
+	return nil
+}
+
+func (dh *CallRecordHook) CallExit(ctx sdk.Context, output []byte, gasUsed uint64, err error) error {
+	dh.Exit++
+	return nil
+}
+
+// CallStoreHook stores the addresses of the internal calls
+type CallStoreHook struct {
+	types.NoOpEvmCallHooks
+	key      storetypes.StoreKey
+	Reverted []common.Address
+}
+
+func (dh *CallStoreHook) CallEnter(ctx sdk.Context, typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) error {
+	ctx.KVStore(dh.key).Set(callStoreKey(to), []byte{1})
+	return nil
+}
+
+func (dh *CallStoreHook) CallExit(ctx sdk.Context, output []byte, gasUsed uint64, err error) error {
+	if err != nil {
+		dh.Reverted = append(dh.Reverted, common.BytesToAddress(output))
+	}
+	return nil
+}
+
+func callStoreKey(addr common.Address) []byte {
+	return append([]byte("call-hook/"), addr.Bytes()...)
+}
+
 func (suite *KeeperTestSuite) TestEvmHooks() {
 	testCases := []struct {
 		msg       string
@@ -87,3 +138,77 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 		tc.expFunc(hook, result)
 	}
 }
+
+func (suite *KeeperTestSuite) TestEvmCallHooks() {
+	testCases := []struct {
+		msg      string
+		hook     *CallRecordHook
+		expEnter int
+		expExit  int
+		expErr   string
+	}{
+		{"call record hook", &CallRecordHook{}, 3, 3, ""},
+		// no hook is run after the first error
+		{"call failure hook", &CallRecordHook{Fail: true}, 1, 0, types.ErrCallProcessing.Error()},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			contract := suite.DeployTestMessageCall(suite.T())
+			suite.app.EvmKeeper.SetCallHooks(keeper.NewMultiEvmCallHooks(tc.hook))
+
+			input, err := types.TestMessageCall.ABI.Pack("benchmarkMessageCall", big.NewInt(3))
+			suite.Require().NoError(err)
+			chainID := suite.app.EvmKeeper.ChainID()
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			tx := types.NewTx(chainID, nonce, &contract, nil, 1_000_000, nil, nil, nil, input, nil)
+			tx.From = suite.address.Hex()
+			suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+			res, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expErr, res.VmError)
+			suite.Require().Equal(tc.expEnter, tc.hook.Enter)
+			suite.Require().Equal(tc.expExit, tc.hook.Exit)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEvmCallHooksRevert() {
+	suite.SetupTest()
+	key := suite.app.GetKey(types.StoreKey)
+	hook := &CallStoreHook{key: key}
+	suite.app.EvmKeeper.SetCallHooks(keeper.NewMultiEvmCallHooks(hook))
+
+	// the contract calls a contract which reverts with its address, then one
+	// which succeeds
+	reverter, succeeder, caller := tests.GenerateAddress(), tests.GenerateAddress(), tests.GenerateAddress()
+	callCode := func(addr common.Address) []byte {
+		// CALL(gas, addr, 0, 0, 0, 0, 0) and POP
+		code := append(bytes.Repeat([]byte{byte(vm.PUSH1), 0}, 5), byte(vm.PUSH20))
+		return append(append(code, addr.Bytes()...), byte(vm.GAS), byte(vm.CALL), byte(vm.POP))
+	}
+	vmdb := suite.StateDB()
+	vmdb.SetCode(reverter, append([]byte{byte(vm.ADDRESS), byte(vm.PUSH1), 0, byte(vm.MSTORE), byte(vm.PUSH1), 32, byte(vm.PUSH1), 0}, byte(vm.REVERT)))
+	vmdb.SetCode(succeeder, []byte{byte(vm.STOP)})
+	vmdb.SetCode(caller, append(append(callCode(reverter), callCode(succeeder)...), byte(vm.STOP)))
+	suite.Require().NoError(vmdb.Commit())
+
+	chainID := suite.app.EvmKeeper.ChainID()
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	tx := types.NewTx(chainID, nonce, &caller, nil, 1_000_000, nil, nil, nil, nil, nil)
+	tx.From = suite.address.Hex()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+	res, err := suite.app.EvmKeeper.EthereumTx(sdk.WrapSDKContext(suite.ctx), tx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VmError)
+
+	// the writes of the hooks of the reverted call are dropped, its exit is
+	// still notified
+	store := suite.ctx.KVStore(key)
+	suite.Require().False(store.Has(callStoreKey(reverter)))
+	suite.Require().True(store.Has(callStoreKey(succeeder)))
+	suite.Require().Equal([]common.Address{reverter}, hook.Reverted)
+}
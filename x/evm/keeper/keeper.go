@@ -30,6 +30,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/precompile/contract"
 
 	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm/statedb"
@@ -68,11 +69,15 @@ type Keeper struct {
 	// Tracer used to collect execution traces from the EVM transaction execution
 	tracer string
 
-	// EVM Hooks for tx post-processing
+	// EVM Hooks for tx pre-processing and post-processing
 	hooks types.EvmHooks
+	// EVM Hooks for the internal calls of the txs
+	callHooks types.EvmCallHooks
 
 	// evm constructor function
 	evmConstructor types.Constructor
+	// stateful precompiled contracts, run once enabled in the params
+	precompiles map[common.Address]contract.StatefulPrecompiledContract
 	// Legacy subspace
 	ss paramstypes.Subspace
 }
@@ -258,6 +263,47 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	return k
 }
 
+// SetCallHooks sets the hooks run on the internal calls of the EVM txs
+// It should be called only once during initialization, it panic if called more than once.
+func (k *Keeper) SetCallHooks(eh types.EvmCallHooks) *Keeper {
+	if k.callHooks != nil {
+		panic("cannot set evm call hooks twice")
+	}
+
+	k.callHooks = eh
+	return k
+}
+
+// WithPrecompiles sets the stateful precompiled contracts of the app by address.
+// The addresses must be registered with statedb.RegisterPrecompile.
+func (k *Keeper) WithPrecompiles(precompiles map[common.Address]contract.StatefulPrecompiledContract) *Keeper {
+	k.precompiles = precompiles
+	return k
+}
+
+// Precompile implements statedb.PrecompileKeeper, the contract is returned if
+// its address is in the enabled precompiles of the params.
+func (k *Keeper) Precompile(ctx sdk.Context, addr common.Address) (contract.StatefulPrecompiledContract, bool) {
+	precompile, ok := k.precompiles[addr]
+	if !ok {
+		return nil, false
+	}
+	for _, enabled := range k.GetParams(ctx).EnabledPrecompiles {
+		if common.HexToAddress(enabled) == addr {
+			return precompile, true
+		}
+	}
+	return nil, false
+}
+
+// PreTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
+func (k *Keeper) PreTxProcessing(ctx sdk.Context, msg core.Message) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.PreTxProcessing(ctx, msg)
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	if k.hooks == nil {
@@ -18,6 +18,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v3 "github.com/evmos/ethermint/x/evm/migrations/v3"
+	v4 "github.com/evmos/ethermint/x/evm/migrations/v4"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -41,3 +42,12 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 		m.keeper.cdc,
 	)
 }
+
+// Migrate3to4 migrates the store from consensus version 3 to 4
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(
+		ctx,
+		m.keeper.storeKey,
+		m.keeper.cdc,
+	)
+}
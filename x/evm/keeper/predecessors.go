@@ -0,0 +1,110 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// applyPredecessors applies the given txs in order on top of the state of the context,
+// the same way they are executed in a block: the fees are deducted from the sender and
+// its nonce is increased as in the ante handler before the message is applied, then
+// the leftover gas is refunded. The txs which can't be applied, like the ones with an
+// invalid nonce or an insufficient balance, are skipped. The txs are applied on a branch
+// of the context which is returned and never written back, so they don't leak into
+// the state of the query context.
+func (k *Keeper) applyPredecessors(ctx sdk.Context, cfg *statedb.EVMConfig, predecessors []*types.MsgEthereumTx) sdk.Context {
+	if len(predecessors) == 0 {
+		return ctx
+	}
+	ctx, _ = ctx.CacheContext()
+
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	for _, predecessor := range predecessors {
+		tx := predecessor.AsTransaction()
+		if tx == nil {
+			k.Logger(ctx).Debug("failed to unpack predecessor", "hash", predecessor.Hash)
+			continue
+		}
+		txConfig.TxHash = tx.Hash()
+		rsp, err := k.applyPredecessor(ctx, cfg, txConfig, signer, tx)
+		if err != nil {
+			k.Logger(ctx).Debug("failed to apply predecessor", "hash", tx.Hash().Hex(), "error", err.Error())
+			continue
+		}
+		txConfig.TxIndex++
+		txConfig.LogIndex += uint(len(rsp.Logs))
+	}
+	return ctx
+}
+
+// applyPredecessor applies a single predecessor tx, the state is only modified if the
+// tx is applied successfully.
+func (k *Keeper) applyPredecessor(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	signer ethtypes.Signer,
+	tx *ethtypes.Transaction,
+) (*types.MsgEthereumTxResponse, error) {
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+
+	from := msg.From()
+	if nonce := k.GetNonce(ctx, from); nonce != msg.Nonce() {
+		return nil, fmt.Errorf("invalid nonce; got %d, expected %d", msg.Nonce(), nonce)
+	}
+
+	cacheCtx, commit := ctx.CacheContext()
+
+	fee := new(big.Int).Mul(msg.GasPrice(), new(big.Int).SetUint64(msg.Gas()))
+	fees := sdk.NewCoins(sdk.NewCoin(cfg.Params.EvmDenom, sdkmath.NewIntFromBigInt(fee)))
+	if err := k.DeductTxCostsFromUserBalance(cacheCtx, fees, from); err != nil {
+		return nil, err
+	}
+
+	account := k.GetAccount(cacheCtx, from)
+	if account == nil {
+		account = statedb.NewEmptyAccount()
+	}
+	account.Nonce++
+	if err := k.SetAccount(cacheCtx, from, *account); err != nil {
+		return nil, err
+	}
+
+	rsp, err := k.ApplyMessageWithConfig(cacheCtx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.RefundGas(cacheCtx, msg, msg.Gas()-rsp.GasUsed, cfg.Params.EvmDenom); err != nil {
+		return nil, err
+	}
+
+	commit()
+	return rsp, nil
+}
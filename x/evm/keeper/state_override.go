@@ -0,0 +1,89 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// applyStateOverride overrides the fields of the specified accounts into the given
+// StateDB, the same way go-ethereum does for `eth_call`.
+func applyStateOverride(stateDB *statedb.StateDB, overrides types.StateOverride) error {
+	if err := overrides.Validate(); err != nil {
+		return err
+	}
+	for addr, account := range overrides {
+		// Override account nonce.
+		if account.Nonce != nil {
+			stateDB.SetNonce(addr, uint64(*account.Nonce))
+		}
+		// Override account(contract) code.
+		if account.Code != nil {
+			stateDB.SetCode(addr, *account.Code)
+		}
+		// Override account balance.
+		if account.Balance != nil && *account.Balance != nil {
+			stateDB.SetBalance(addr, (*account.Balance).ToInt())
+		}
+		// Replace entire state if caller requires.
+		if account.State != nil {
+			stateDB.SetStorage(addr, *account.State)
+		}
+		// Apply state diff into specified accounts.
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+	}
+	return nil
+}
+
+// getNonceWithOverride returns the nonce of the account, taking the state overrides
+// into account.
+func (k Keeper) getNonceWithOverride(ctx sdk.Context, addr common.Address, overrides types.StateOverride) uint64 {
+	if account, ok := overrides[addr]; ok && account.Nonce != nil {
+		return uint64(*account.Nonce)
+	}
+	return k.GetNonce(ctx, addr)
+}
+
+// applyBlockOverrides overrides the block context of the call with the given header
+// fields, it returns the updated context, the EVM config is modified in place.
+func applyBlockOverrides(ctx sdk.Context, cfg *statedb.EVMConfig, overrides *types.BlockOverrides) sdk.Context {
+	if overrides == nil {
+		return ctx
+	}
+	if overrides.Number != nil {
+		ctx = ctx.WithBlockHeight(overrides.Number.ToInt().Int64())
+	}
+	if overrides.Time != nil {
+		ctx = ctx.WithBlockTime(time.Unix(int64(*overrides.Time), 0).UTC())
+	}
+	if overrides.Coinbase != nil {
+		cfg.CoinBase = *overrides.Coinbase
+	}
+	if overrides.BaseFee != nil {
+		cfg.BaseFee = overrides.BaseFee.ToInt()
+	}
+	return ctx
+}
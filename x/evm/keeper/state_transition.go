@@ -163,10 +163,10 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
 	}
 
-	// snapshot to contain the tx processing and post processing in same scope
+	// snapshot to contain the pre processing, tx processing and post processing in same scope
 	var commit func()
 	tmpCtx := ctx
-	if k.hooks != nil {
+	if k.hooks != nil || k.callHooks != nil {
 		// Create a cache context to revert state when tx hooks fails,
 		// the cache context is only committed when both tx and hooks executed successfully.
 		// Didn't use `Snapshot` because the context stack has exponential complexity on certain operations,
@@ -174,10 +174,28 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		tmpCtx, commit = ctx.CacheContext()
 	}
 
-	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
+	// the call hooks are run by a tracer wrapping the default one
+	tracer := k.Tracer(ctx, msg, cfg.ChainConfig)
+	var callHooksTracer *callHooksTracer
+	if k.callHooks != nil {
+		callHooksTracer = newCallHooksTracer(tmpCtx, k.callHooks, tracer)
+		tracer = callHooksTracer
+	}
+
+	var res *types.MsgEthereumTxResponse
+	if err = k.PreTxProcessing(tmpCtx, msg); err != nil {
+		// If hooks return error, revert the whole tx without executing it.
+		k.Logger(ctx).Error("tx pre processing failed", "error", err)
+		res, err = k.preTxProcessingFailed(ctx, msg, cfg, txConfig)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// pass true to commit the StateDB
+		res, err = k.ApplyMessageWithConfig(tmpCtx, msg, tracer, true, cfg, txConfig)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
+		}
 	}
 
 	logs := types.LogsToEthereum(res.Logs)
@@ -220,7 +238,14 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	if !res.Failed() {
 		receipt.Status = ethtypes.ReceiptStatusSuccessful
 		// Only call hooks if tx executed successfully.
-		if err = k.PostTxProcessing(tmpCtx, msg, receipt); err != nil {
+		if callHooksTracer != nil && callHooksTracer.err != nil {
+			// If call hooks return error, revert the whole tx.
+			res.VmError = types.ErrCallProcessing.Error()
+			k.Logger(ctx).Error("tx call processing failed", "error", callHooksTracer.err)
+
+			// If the tx failed in call processing hooks, we should clear the logs
+			res.Logs = nil
+		} else if err = k.PostTxProcessing(tmpCtx, msg, receipt); err != nil {
 			// If hooks return error, revert the whole tx.
 			res.VmError = types.ErrPostTxProcessing.Error()
 			k.Logger(ctx).Error("tx post processing failed", "error", err)
@@ -259,6 +284,31 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	return res, nil
 }
 
+// preTxProcessingFailed returns the response of a tx reverted by the PreTxProcessing hooks. The tx isn't executed, but
+// its intrinsic gas is charged, with the same minimum as the executed txs.
+func (k *Keeper) preTxProcessingFailed(
+	ctx sdk.Context,
+	msg core.Message,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, error) {
+	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, msg.To() == nil)
+	if err != nil {
+		// should have already been checked on Ante Handler
+		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
+	}
+
+	gasLimit := sdk.NewDec(int64(msg.Gas()))
+	minimumGasUsed := gasLimit.Mul(k.GetMinGasMultiplier(ctx))
+	gasUsed := sdk.MinDec(gasLimit, sdk.MaxDec(minimumGasUsed, sdk.NewDec(int64(intrinsicGas)))).TruncateInt().Uint64()
+
+	return &types.MsgEthereumTxResponse{
+		GasUsed: gasUsed,
+		VmError: types.ErrPreTxProcessing.Error(),
+		Hash:    txConfig.TxHash.Hex(),
+	}, nil
+}
+
 // ApplyMessage calls ApplyMessageWithConfig with an empty TxConfig.
 func (k *Keeper) ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*types.MsgEthereumTxResponse, error) {
 	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
@@ -314,6 +364,21 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	commit bool,
 	cfg *statedb.EVMConfig,
 	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, error) {
+	return k.ApplyMessageWithStateOverride(ctx, msg, tracer, commit, cfg, txConfig, nil)
+}
+
+// ApplyMessageWithStateOverride is ApplyMessageWithConfig, but the given state overrides
+// are applied to the `StateDB` before the message is executed.
+// It's used by the `EthCall/EthEstimateGas` grpc query handlers, the overrides are
+// never meant to be committed.
+func (k *Keeper) ApplyMessageWithStateOverride(ctx sdk.Context,
+	msg core.Message,
+	tracer vm.EVMLogger,
+	commit bool,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+	overrides types.StateOverride,
 ) (*types.MsgEthereumTxResponse, error) {
 	var (
 		ret   []byte // return bytes from evm execution
@@ -328,7 +393,21 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 	}
 
 	stateDB := statedb.New(ctx, k, txConfig)
+	if err := applyStateOverride(stateDB, overrides); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to apply state overrides")
+	}
+	// the calls to the blocked contracts revert with the revert reason
+	if len(cfg.Params.BlockedContracts) > 0 {
+		stateDB.SetCodeStubs(blockedContractStubs(cfg.Params))
+	}
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
+	// the contract creations of the deployers which aren't allowed are
+	// recorded in the create path
+	var deployers *deployerCheck
+	if len(cfg.Params.AllowedDeployers) > 0 {
+		deployers = newDeployerCheck(cfg.Params, stateDB)
+		evm.Context.Transfer = deployers.transfer(evm.Context.Transfer)
+	}
 
 	leftoverGas := msg.Gas()
 
@@ -364,17 +443,35 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		stateDB.PrepareAccessList(msg.From(), msg.To(), vm.ActivePrecompiles(rules), msg.AccessList())
 	}
 
+	snapshot := stateDB.Snapshot()
 	if contractCreation {
+		// a deployer which isn't allowed runs the code reverting with the
+		// revert reason instead of its init code
+		code := msg.Data()
+		if !cfg.Params.IsDeployerAllowed(sender.Address()) {
+			code = revertStub(errorsmod.Wrap(types.ErrDeployerNotAllowed, sender.Address().Hex()))
+		}
 		// take over the nonce management from evm:
 		// - reset sender's nonce to msg.Nonce() before calling evm.
 		// - increase sender's nonce by one no matter the result.
 		stateDB.SetNonce(sender.Address(), msg.Nonce())
-		ret, _, leftoverGas, vmErr = evm.Create(sender, msg.Data(), leftoverGas, msg.Value())
+		ret, _, leftoverGas, vmErr = evm.Create(sender, code, leftoverGas, msg.Value())
 		stateDB.SetNonce(sender.Address(), msg.Nonce()+1)
 	} else {
 		ret, leftoverGas, vmErr = evm.Call(sender, *msg.To(), msg.Data(), leftoverGas, msg.Value())
 	}
 
+	// a contract created by a deployer which isn't allowed, in a call which
+	// isn't reverted, fails the message with the revert reason
+	if deployers != nil && deployers.denied != nil {
+		// the revert of the state resets the denied creation
+		ret, vmErr = revertData(deployers.denied), vm.ErrExecutionReverted
+		stateDB.RevertToSnapshot(snapshot)
+		if contractCreation {
+			stateDB.SetNonce(sender.Address(), msg.Nonce()+1)
+		}
+	}
+
 	refundQuotient := params.RefundQuotient
 
 	// After EIP-3529: refunds are capped to gasUsed / 5
@@ -1,9 +1,11 @@
 package keeper_test
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -11,9 +13,13 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/ethermint/tests"
 	"github.com/evmos/ethermint/x/evm/keeper"
@@ -662,6 +668,224 @@ func (suite *KeeperTestSuite) TestApplyMessageWithConfig() {
 	}
 }
 
+// sorted returns the hex addresses sorted as the address lists of the params.
+func sorted(addrs ...common.Address) []string {
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0 })
+	hexAddrs := make([]string, len(addrs))
+	for i, addr := range addrs {
+		hexAddrs[i] = addr.Hex()
+	}
+	return hexAddrs
+}
+
+func (suite *KeeperTestSuite) TestApplyMessageAccessControl() {
+	var (
+		contract = crypto.CreateAddress(suite.address, 0)
+		inner    = crypto.CreateAddress(contract, 1)
+		other    = tests.GenerateAddress()
+	)
+	requireReverted := func(res *types.MsgEthereumTxResponse, expReason error) {
+		suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+		if expReason == nil {
+			suite.Require().Empty(res.Ret)
+			return
+		}
+		reason, err := abi.UnpackRevert(res.Ret)
+		suite.Require().NoError(err)
+		suite.Require().Contains(reason, expReason.Error())
+	}
+	callInput, err := types.TestMessageCall.ABI.Pack("benchmarkMessageCall", big.NewInt(1))
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		msg              string
+		allowedDeployers []string
+		blockedContracts []string
+		expCreateFailed  bool
+		expCreateReason  error
+		expCallReason    error
+	}{
+		{"no access control", nil, nil, false, nil, nil},
+		{"deployer not allowed", sorted(other), nil, true, types.ErrDeployerNotAllowed, nil},
+		// the creation of the inner contract fails the whole message
+		{"factory not allowed", sorted(suite.address), nil, true, types.ErrDeployerNotAllowed, nil},
+		{"deployer and factory allowed", sorted(suite.address, contract), nil, false, nil, nil},
+		{"internal call blocked", nil, sorted(inner), false, nil, types.ErrContractBlocked},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.AllowedDeployers = tc.allowedDeployers
+			params.BlockedContracts = tc.blockedContracts
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			msg := ethtypes.NewMessage(suite.address, nil, nonce, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), types.TestMessageCall.Bin, nil, false)
+			res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+			suite.Require().NoError(err)
+			if tc.expCreateFailed {
+				// the transaction fails with a receipt, and the gas left is refunded
+				requireReverted(res, tc.expCreateReason)
+				suite.Require().Less(res.GasUsed, msg.Gas())
+				suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, contract))
+				suite.Require().Equal(nonce+1, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+				return
+			}
+			suite.Require().False(res.Failed(), res.VmError)
+
+			msg = ethtypes.NewMessage(suite.address, &contract, nonce+1, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), callInput, nil, false)
+			res, err = suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+			suite.Require().NoError(err)
+			if tc.expCallReason != nil {
+				// the revert data of the blocked call is bubbled up by the contract
+				requireReverted(res, tc.expCallReason)
+				suite.Require().Empty(res.Logs)
+				return
+			}
+			suite.Require().False(res.Failed(), res.VmError)
+
+			// the contract itself can be blocked too, its code is kept
+			params.BlockedContracts = sorted(contract)
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+			res, err = suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+			suite.Require().NoError(err)
+			requireReverted(res, types.ErrContractBlocked)
+			suite.Require().Less(res.GasUsed, msg.Gas())
+			suite.Require().NotEmpty(suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(suite.app.EvmKeeper.GetAccount(suite.ctx, contract).CodeHash)))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestApplyMessageAccessControlSubCalls() {
+	suite.SetupTest()
+	blocked, caller, factory := tests.GenerateAddress(), tests.GenerateAddress(), tests.GenerateAddress()
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.AllowedDeployers = sorted(suite.address)
+	params.BlockedContracts = sorted(blocked)
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	// the caller returns the success of CALL(gas, blocked, 0, 0, 0, 0, 0)
+	// followed by its return data, the factory returns the address of
+	// CREATE(0, 0, 0)
+	callCode := append(bytes.Repeat([]byte{byte(vm.PUSH1), 0}, 5), byte(vm.PUSH20))
+	callCode = append(append(callCode, blocked.Bytes()...), byte(vm.GAS), byte(vm.CALL), byte(vm.PUSH1), 0, byte(vm.MSTORE))
+	callCode = append(callCode,
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 32, byte(vm.RETURNDATACOPY),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 32, byte(vm.ADD), byte(vm.PUSH1), 0, byte(vm.RETURN),
+	)
+	factoryCode := append(bytes.Repeat([]byte{byte(vm.PUSH1), 0}, 3), byte(vm.CREATE), byte(vm.PUSH1), 0, byte(vm.MSTORE))
+	factoryCode = append(factoryCode, byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN))
+	vmdb := suite.StateDB()
+	vmdb.SetCode(blocked, []byte{byte(vm.STOP)})
+	vmdb.SetCode(caller, callCode)
+	vmdb.SetCode(factory, factoryCode)
+	suite.Require().NoError(vmdb.Commit())
+
+	// the blocked call fails on its own with the revert data
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	msg := ethtypes.NewMessage(suite.address, &caller, nonce, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false)
+	res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.Hash{}, common.BytesToHash(res.Ret[:32]))
+	reason, err := abi.UnpackRevert(res.Ret[32:])
+	suite.Require().NoError(err)
+	suite.Require().Contains(reason, types.ErrContractBlocked.Error())
+
+	// the creation fails the whole message with the revert data
+	msg = ethtypes.NewMessage(suite.address, &factory, nonce+1, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false)
+	res, err = suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+	suite.Require().Less(res.GasUsed, msg.Gas())
+	reason, err = abi.UnpackRevert(res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Contains(reason, types.ErrDeployerNotAllowed.Error())
+	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetNonce(suite.ctx, factory))
+
+	// a reverted creation doesn't fail the message, the factory returns the
+	// zero address of CREATE(0, 27, 5) with the init code
+	// REVERT(0, 0) stored in memory
+	revertFactory := tests.GenerateAddress()
+	revertFactoryCode := []byte{byte(vm.PUSH5), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.REVERT), byte(vm.PUSH1), 0, byte(vm.MSTORE)}
+	revertFactoryCode = append(revertFactoryCode, byte(vm.PUSH1), 5, byte(vm.PUSH1), 27, byte(vm.PUSH1), 0, byte(vm.CREATE))
+	revertFactoryCode = append(revertFactoryCode, byte(vm.PUSH1), 0, byte(vm.MSTORE), byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN))
+	vmdb = suite.StateDB()
+	vmdb.SetCode(revertFactory, revertFactoryCode)
+	suite.Require().NoError(vmdb.Commit())
+	msg = ethtypes.NewMessage(suite.address, &revertFactory, nonce+2, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false)
+	res, err = suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.Hash{}, common.BytesToHash(res.Ret))
+
+	// the factory creates the contract once allowed
+	msg = ethtypes.NewMessage(suite.address, &factory, nonce+3, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false)
+	params.AllowedDeployers = sorted(suite.address, factory)
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+	res, err = suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(crypto.CreateAddress(factory, 0), common.BytesToAddress(res.Ret))
+}
+
+func (suite *KeeperTestSuite) TestApplyMessageBlockedContractCode() {
+	suite.SetupTest()
+	blocked, inspector := tests.GenerateAddress(), tests.GenerateAddress()
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.BlockedContracts = sorted(blocked)
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+	// the inspector returns EXTCODESIZE, EXTCODEHASH and the 256 first bytes
+	// of EXTCODECOPY of the blocked contract
+	extCode := func(op vm.OpCode) []byte {
+		return append([]byte{byte(vm.PUSH20)}, append(blocked.Bytes(), byte(op))...)
+	}
+	inspectorCode := append(extCode(vm.EXTCODESIZE), byte(vm.PUSH1), 0, byte(vm.MSTORE))
+	inspectorCode = append(append(inspectorCode, extCode(vm.EXTCODEHASH)...), byte(vm.PUSH1), 32, byte(vm.MSTORE))
+	inspectorCode = append(inspectorCode, byte(vm.PUSH2), 1, 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 64)
+	inspectorCode = append(append(inspectorCode, byte(vm.PUSH20)), blocked.Bytes()...)
+	inspectorCode = append(inspectorCode, byte(vm.EXTCODECOPY), byte(vm.PUSH2), 1, 64, byte(vm.PUSH1), 0, byte(vm.RETURN))
+	vmdb := suite.StateDB()
+	vmdb.SetCode(blocked, []byte{byte(vm.STOP)})
+	vmdb.SetCode(inspector, inspectorCode)
+	suite.Require().NoError(vmdb.Commit())
+
+	// the code, its size and its hash are the ones of the stub
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	msg := ethtypes.NewMessage(suite.address, &inspector, nonce, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false)
+	res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	size := new(big.Int).SetBytes(res.Ret[:32]).Uint64()
+	suite.Require().NotEqual(uint64(1), size)
+	suite.Require().LessOrEqual(size, uint64(256))
+	code := res.Ret[64 : 64+size]
+	suite.Require().Equal(crypto.Keccak256Hash(code), common.BytesToHash(res.Ret[32:64]))
+	suite.Require().True(bytes.Contains(code, []byte(types.ErrContractBlocked.Error())))
+
+	// the code of a state override isn't replaced by the stub
+	overrideCode := hexutil.Bytes{byte(vm.PUSH1), 42, byte(vm.PUSH1), 0, byte(vm.MSTORE), byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN)}
+	overrides := types.StateOverride{blocked: types.OverrideAccount{Code: &overrideCode}}
+	cfg, err := suite.app.EvmKeeper.EVMConfig(suite.ctx, suite.ctx.BlockHeader().ProposerAddress, big.NewInt(9000))
+	suite.Require().NoError(err)
+	txConfig := suite.app.EvmKeeper.TxConfig(suite.ctx, common.Hash{})
+	msg = ethtypes.NewMessage(suite.address, &blocked, nonce+1, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false)
+	res, err = suite.app.EvmKeeper.ApplyMessageWithStateOverride(suite.ctx, msg, nil, false, cfg, txConfig, overrides)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(common.BigToHash(big.NewInt(42)), common.BytesToHash(res.Ret))
+
+	msg = ethtypes.NewMessage(suite.address, &inspector, nonce+1, big.NewInt(0), 1_000_000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, false)
+	res, err = suite.app.EvmKeeper.ApplyMessageWithStateOverride(suite.ctx, msg, nil, false, cfg, txConfig, overrides)
+	suite.Require().NoError(err)
+	suite.Require().False(res.Failed(), res.VmError)
+	suite.Require().Equal(uint64(len(overrideCode)), new(big.Int).SetBytes(res.Ret[:32]).Uint64())
+	suite.Require().Equal(crypto.Keccak256Hash(overrideCode), common.BytesToHash(res.Ret[32:64]))
+}
+
 func (suite *KeeperTestSuite) createContractGethMsg(nonce uint64, signer ethtypes.Signer, cfg *params.ChainConfig, gasPrice *big.Int) (core.Message, error) {
 	ethMsg, err := suite.createContractMsgTx(nonce, signer, cfg, gasPrice)
 	if err != nil {
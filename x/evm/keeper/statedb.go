@@ -31,7 +31,10 @@ import (
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
-var _ statedb.Keeper = &Keeper{}
+var (
+	_ statedb.Keeper           = &Keeper{}
+	_ statedb.PrecompileKeeper = &Keeper{}
+)
 
 // ----------------------------------------------------------------------------
 // StateDB Keeper implementation
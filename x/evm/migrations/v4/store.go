@@ -0,0 +1,39 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// MigrateStore migrates the params from consensus version 3 to 4, adding the
+// AllowedDeployers and BlockedContracts parameters.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	// load existing parameters, they are stored by the v3 migration
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(types.KeyPrefixParams), &params); err != nil {
+		return err
+	}
+
+	// New fields are empty, any address can create contracts and no contract
+	// is blocked. These should be empty if we leave them out because of the
+	// default value, but set explicitly here to nil.
+	params.AllowedDeployers = nil
+	params.BlockedContracts = nil
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&params)
+	store.Set(types.KeyPrefixParams, bz)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/encoding"
+	v4 "github.com/evmos/ethermint/x/evm/migrations/v4"
+	"github.com/evmos/ethermint/x/evm/types"
+	legacytestutil "github.com/evmos/ethermint/x/evm/types/legacy/testutil"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey(types.TransientKey)
+	ctx := legacytestutil.NewDBContext([]storetypes.StoreKey{storeKey}, []storetypes.StoreKey{tKey})
+	kvStore := ctx.KVStore(storeKey)
+
+	initialParams := types.DefaultParams()
+	initialParams.EvmDenom = "akava"
+	initialParams.EnabledPrecompiles = []string{"0x0000000000000000000000000000000000000800"}
+	kvStore.Set(types.KeyPrefixParams, cdc.MustMarshal(&initialParams))
+
+	err := v4.MigrateStore(ctx, storeKey, cdc)
+	require.NoError(t, err)
+
+	// Get all the new parameters from the kvStore
+	paramsBz := kvStore.Get(types.KeyPrefixParams)
+	var migratedParams types.Params
+	cdc.MustUnmarshal(paramsBz, &migratedParams)
+
+	// ensure migrated params match initial params, without any access control
+	require.Equal(t, initialParams, migratedParams)
+	require.Empty(t, migratedParams.AllowedDeployers)
+	require.Empty(t, migratedParams.BlockedContracts)
+}
@@ -43,7 +43,7 @@ var (
 )
 
 // ConsensusVersion defines the current module consensus version.
-const ConsensusVersion = 3
+const ConsensusVersion = 4
 
 // AppModuleBasic defines the basic application module used by the evm module.
 type AppModuleBasic struct{}
@@ -147,6 +147,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+
+	err = cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // BeginBlock returns the begin block for the evm module.
@@ -25,10 +25,14 @@ import (
 // codebase to support additional state transition functionalities. In particular
 // it supports appending a new entry to the state journal through
 // AppendJournalEntry so that the state can be reverted after running
-// stateful precompiled contracts.
+// stateful precompiled contracts. The contracts read the native state from
+// CacheContext and change it through ExecuteNativeAction, the changes are
+// committed along the EVM states.
 type ExtStateDB interface {
 	vm.StateDB
 	AppendJournalEntry(JournalEntry)
+	CacheContext() sdk.Context
+	ExecuteNativeAction(gasMeter sdk.GasMeter, action func(ctx sdk.Context) error) error
 }
 
 // Keeper provide underlying storage of StateDB
@@ -21,6 +21,7 @@ import (
 	"math/big"
 	"sort"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -130,6 +131,11 @@ type (
 		prev uint64
 	}
 	addLogChange struct{}
+	// Changes to the native state, by the precompiled contracts.
+	cacheContextChange struct {
+		prev  sdk.Context
+		depth int
+	}
 
 	// Changes to the access list
 	accessListAddAccountChange struct {
@@ -217,6 +223,24 @@ func (ch addLogChange) Dirtied() *common.Address {
 	return nil
 }
 
+func (ch cacheContextChange) Revert(s *StateDB) {
+	s.cacheCtx = ch.prev
+	s.cacheWrites = s.cacheWrites[:ch.depth]
+
+	// the clean accounts may have been loaded from the dropped branch, they're
+	// loaded again when accessed. The later changes of the dirty ones are
+	// already reverted.
+	for addr := range s.stateObjects {
+		if _, dirty := s.journal.dirties[addr]; !dirty {
+			delete(s.stateObjects, addr)
+		}
+	}
+}
+
+func (ch cacheContextChange) Dirtied() *common.Address {
+	return nil
+}
+
 func (ch accessListAddAccountChange) Revert(s *StateDB) {
 	/*
 		One important invariant here, is that whenever a (addr, slot) is added, if the
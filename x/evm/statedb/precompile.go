@@ -0,0 +1,76 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package statedb
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/precompile/contract"
+	"github.com/ethereum/go-ethereum/precompile/modules"
+)
+
+// PrecompileKeeper is implemented by the keepers providing the stateful
+// precompiled contracts to the StateDB.
+type PrecompileKeeper interface {
+	// Precompile returns the contract at the address, false if there's none or
+	// if it's not enabled.
+	Precompile(ctx sdk.Context, addr common.Address) (contract.StatefulPrecompiledContract, bool)
+}
+
+// RegisterPrecompile registers the address as a go-ethereum precompile module.
+// The modules are global to the process while the contracts depend on the
+// keepers of an app, so the registered module only forwards the calls to the
+// contract returned by the keeper of the StateDB. It panics if the address is
+// already registered.
+func RegisterPrecompile(addr common.Address) {
+	if err := modules.RegisterModule(modules.Module{
+		Address:  addr,
+		Contract: precompile{address: addr},
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// precompile forwards the calls to the contract of the StateDB keeper.
+type precompile struct {
+	address common.Address
+}
+
+// Run implements contract.StatefulPrecompiledContract. The calls to a contract
+// which isn't enabled succeed without any effect, like the calls to an empty
+// account.
+func (p precompile) Run(
+	accessibleState contract.AccessibleState,
+	caller common.Address,
+	addr common.Address,
+	input []byte,
+	suppliedGas uint64,
+	readOnly bool,
+) ([]byte, uint64, error) {
+	stateDB, ok := accessibleState.GetStateDB().(*StateDB)
+	if !ok {
+		return nil, suppliedGas, nil
+	}
+	keeper, ok := stateDB.keeper.(PrecompileKeeper)
+	if !ok {
+		return nil, suppliedGas, nil
+	}
+	c, ok := keeper.Precompile(stateDB.ctx, p.address)
+	if !ok {
+		return nil, suppliedGas, nil
+	}
+	return c.Run(accessibleState, caller, addr, input, suppliedGas, readOnly)
+}
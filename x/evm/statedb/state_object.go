@@ -74,6 +74,9 @@ type stateObject struct {
 	// state storage
 	originStorage Storage
 	dirtyStorage  Storage
+	// fakeStorage replaces the whole committed storage when set, it's only
+	// used to override the state of simulated calls.
+	fakeStorage Storage
 
 	address common.Address
 
@@ -214,6 +217,10 @@ func (s *stateObject) Nonce() uint64 {
 
 // GetCommittedState query the committed state
 func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
+	// If the fake storage is set, only lookup the state here
+	if s.fakeStorage != nil {
+		return s.fakeStorage[key]
+	}
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
@@ -250,3 +257,19 @@ func (s *stateObject) SetState(key common.Hash, value common.Hash) {
 func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
+
+// SetStorage replaces the entire state storage with the given one.
+//
+// After this function is called, all original state will be ignored and state
+// lookup only happens in the fake state storage.
+//
+// Note this function should only be used for debugging purpose.
+func (s *stateObject) SetStorage(storage Storage) {
+	// Allocate fake storage if it's nil.
+	if s.fakeStorage == nil {
+		s.fakeStorage = make(Storage)
+	}
+	for key, value := range storage {
+		s.fakeStorage[key] = value
+	}
+}
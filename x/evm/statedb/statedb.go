@@ -16,6 +16,7 @@
 package statedb
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"sort"
@@ -36,7 +37,19 @@ type revision struct {
 	journalIndex int
 }
 
-var _ vm.StateDB = &StateDB{}
+var (
+	_ vm.StateDB = &StateDB{}
+	_ ExtStateDB = &StateDB{}
+)
+
+// MaxNativeActions is the max number of native actions run by the stateful
+// precompiled contracts in a transaction. Each action is run on a branch of the
+// previous one, so the reads of the native state go through all of them.
+const MaxNativeActions = 64
+
+// ErrMaxNativeActions is returned when a transaction runs more than
+// MaxNativeActions native actions.
+var ErrMaxNativeActions = fmt.Errorf("max native actions of the transaction exceeded (%d)", MaxNativeActions)
 
 // StateDB structs within the ethereum protocol are used to store anything
 // within the merkle trie. StateDBs take care of caching and storing
@@ -65,6 +78,16 @@ type StateDB struct {
 
 	// Per-transaction access list
 	accessList *accessList
+
+	// The branches of ctx the stateful precompiled contracts write to, and
+	// the functions writing each of them to its parent on commit.
+	cacheCtx    sdk.Context
+	cacheWrites []func()
+	// the number of native actions run by the transaction, reverted or not
+	nativeActions int
+
+	// The code run by the EVM instead of the stored code of the contracts.
+	codeStubs map[common.Address][]byte
 }
 
 // New creates a new state from a given trie.
@@ -85,6 +108,85 @@ func (s *StateDB) Keeper() Keeper {
 	return s.keeper
 }
 
+// CacheContext returns the context the stateful precompiled contracts read the
+// native state from. It's branched from the StateDB context on first use, with
+// an infinite gas meter since the EVM gas is charged by the contracts, and
+// written to it on commit.
+func (s *StateDB) CacheContext() sdk.Context {
+	if len(s.cacheWrites) == 0 {
+		var write func()
+		s.cacheCtx, write = s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+		s.cacheWrites = append(s.cacheWrites, write)
+	}
+	return s.cacheCtx
+}
+
+// ExecuteNativeAction runs the native state changes of a stateful precompiled
+// contract on a branch of the cache context, journaled so it's dropped when the
+// EVM call is reverted. The action sees the balances of the ongoing EVM
+// execution, and the balances it changes are then applied to the EVM accounts.
+// The KV store reads and writes of the action and of the balances sync are
+// charged to the gas meter. Nothing is changed if the action returns an error,
+// and ErrMaxNativeActions is returned once the transaction ran MaxNativeActions.
+func (s *StateDB) ExecuteNativeAction(gasMeter sdk.GasMeter, action func(ctx sdk.Context) error) error {
+	if s.nativeActions >= MaxNativeActions {
+		return ErrMaxNativeActions
+	}
+	s.nativeActions++
+
+	parent := s.CacheContext()
+	ctx, write := parent.WithGasMeter(gasMeter).CacheContext()
+
+	addrs := make([]common.Address, 0, len(s.stateObjects))
+	for addr := range s.stateObjects {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+
+	for _, addr := range addrs {
+		obj := s.stateObjects[addr]
+		if obj.Balance().Cmp(s.nativeBalance(ctx, addr)) == 0 {
+			continue
+		}
+		if err := s.keeper.SetAccount(ctx, addr, obj.account); err != nil {
+			return errorsmod.Wrap(err, "failed to set account")
+		}
+	}
+
+	if err := action(ctx); err != nil {
+		return err
+	}
+
+	s.journal.append(cacheContextChange{prev: parent, depth: len(s.cacheWrites)})
+	s.cacheCtx = ctx.WithGasMeter(parent.GasMeter())
+	s.cacheWrites = append(s.cacheWrites, write)
+
+	for _, addr := range addrs {
+		obj := s.stateObjects[addr]
+		if balance := s.nativeBalance(ctx, addr); obj.Balance().Cmp(balance) != 0 {
+			obj.SetBalance(balance)
+		}
+	}
+	return nil
+}
+
+// nativeBalance returns the balance of the account in the context.
+func (s *StateDB) nativeBalance(ctx sdk.Context, addr common.Address) *big.Int {
+	account := s.keeper.GetAccount(ctx, addr)
+	if account == nil {
+		return new(big.Int)
+	}
+	return account.Balance
+}
+
+// AppendJournalEntry adds an entry to the journal, it's reverted along the
+// native state changes.
+func (s *StateDB) AppendJournalEntry(entry JournalEntry) {
+	s.journal.append(entry)
+}
+
 // AddLog adds a log, called by evm.
 func (s *StateDB) AddLog(log *ethtypes.Log) {
 	s.journal.append(addLogChange{})
@@ -151,6 +253,9 @@ func (s *StateDB) GetNonce(addr common.Address) uint64 {
 
 // GetCode returns the code of account, nil if not exists.
 func (s *StateDB) GetCode(addr common.Address) []byte {
+	if stub, ok := s.codeStub(addr); ok {
+		return stub
+	}
 	stateObject := s.getStateObject(addr)
 	if stateObject != nil {
 		return stateObject.Code()
@@ -158,8 +263,34 @@ func (s *StateDB) GetCode(addr common.Address) []byte {
 	return nil
 }
 
+// SetCodeStubs sets the code of the accounts seen by the EVM, returned by
+// GetCode, GetCodeSize and GetCodeHash, instead of their stored code. The
+// stored code is left as is and committed unchanged. The accounts without code
+// and the code set in the StateDB, by a state override or a contract creation,
+// aren't replaced.
+func (s *StateDB) SetCodeStubs(stubs map[common.Address][]byte) {
+	s.codeStubs = stubs
+}
+
+// codeStub returns the code stub of the account if it has a stored code which
+// isn't replaced in the StateDB.
+func (s *StateDB) codeStub(addr common.Address) ([]byte, bool) {
+	stub, ok := s.codeStubs[addr]
+	if !ok {
+		return nil, false
+	}
+	stateObject := s.getStateObject(addr)
+	if stateObject == nil || stateObject.dirtyCode || bytes.Equal(stateObject.CodeHash(), emptyCodeHash) {
+		return nil, false
+	}
+	return stub, true
+}
+
 // GetCodeSize returns the code size of account.
 func (s *StateDB) GetCodeSize(addr common.Address) int {
+	if stub, ok := s.codeStub(addr); ok {
+		return len(stub)
+	}
 	stateObject := s.getStateObject(addr)
 	if stateObject != nil {
 		return stateObject.CodeSize()
@@ -169,6 +300,9 @@ func (s *StateDB) GetCodeSize(addr common.Address) int {
 
 // GetCodeHash returns the code hash of account.
 func (s *StateDB) GetCodeHash(addr common.Address) common.Hash {
+	if stub, ok := s.codeStub(addr); ok {
+		return crypto.Keccak256Hash(stub)
+	}
 	stateObject := s.getStateObject(addr)
 	if stateObject == nil {
 		return common.Hash{}
@@ -221,8 +355,13 @@ func (s *StateDB) getStateObject(addr common.Address) *stateObject {
 	if obj := s.stateObjects[addr]; obj != nil {
 		return obj
 	}
-	// If no live objects are available, load it from keeper
-	account := s.keeper.GetAccount(s.ctx, addr)
+	// If no live objects are available, load it from keeper, with the
+	// changes of the precompiled contracts
+	ctx := s.ctx
+	if len(s.cacheWrites) > 0 {
+		ctx = s.cacheCtx
+	}
+	account := s.keeper.GetAccount(ctx, addr)
 	if account == nil {
 		return nil
 	}
@@ -276,6 +415,28 @@ func (s *StateDB) CreateAccount(addr common.Address) {
 	}
 }
 
+// IsCreatingContract returns true if the EVM is creating the contract at the
+// address: its account was just created and its nonce set to 1, before the
+// transfer of the endowment of the creation. The accounts created by the calls
+// keep a zero nonce until the transfer of their value.
+func (s *StateDB) IsCreatingContract(addr common.Address) bool {
+	n := len(s.journal.entries)
+	if n < 2 {
+		return false
+	}
+	nonce, ok := s.journal.entries[n-1].(nonceChange)
+	if !ok || *nonce.account != addr || nonce.prev != 0 {
+		return false
+	}
+	switch entry := s.journal.entries[n-2].(type) {
+	case createObjectChange:
+		return *entry.account == addr
+	case resetObjectChange:
+		return entry.prev.address == addr
+	}
+	return false
+}
+
 // ForEachStorage iterate the contract storage, the iteration order is not defined.
 func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.Hash) bool) error {
 	so := s.getStateObject(addr)
@@ -318,6 +479,14 @@ func (s *StateDB) SubBalance(addr common.Address, amount *big.Int) {
 	}
 }
 
+// SetBalance sets the balance of account.
+func (s *StateDB) SetBalance(addr common.Address, amount *big.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(amount)
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -342,6 +511,16 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	}
 }
 
+// SetStorage replaces the entire storage of the account with the given one,
+// the original storage is ignored afterwards. It should only be used to
+// override the state of simulated calls, and the StateDB must not be committed.
+func (s *StateDB) SetStorage(addr common.Address, storage Storage) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetStorage(storage)
+	}
+}
+
 // Suicide marks the given account as suicided.
 // This clears the account balance.
 //
@@ -451,6 +630,11 @@ func (s *StateDB) RevertToSnapshot(revid int) {
 // Commit writes the dirty states to keeper
 // the StateDB object should be discarded after committed.
 func (s *StateDB) Commit() error {
+	// the changes of the precompiled contracts go first, from the innermost
+	// branch, the accounts are then updated with the EVM states
+	for i := len(s.cacheWrites) - 1; i >= 0; i-- {
+		s.cacheWrites[i]()
+	}
 	for _, addr := range s.journal.sortedDirties() {
 		obj := s.stateObjects[addr]
 		if obj.suicided {
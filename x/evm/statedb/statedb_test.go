@@ -565,6 +565,38 @@ func (suite *StateDBTestSuite) TestIterateStorage() {
 	suite.Require().Equal(1, len(storage))
 }
 
+// counterChange is a journal entry external to the StateDB.
+type counterChange struct {
+	counter *int
+}
+
+func (ch counterChange) Revert(*statedb.StateDB) {
+	*ch.counter--
+}
+
+func (ch counterChange) Dirtied() *common.Address {
+	return nil
+}
+
+func (suite *StateDBTestSuite) TestAppendJournalEntry() {
+	db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
+	counter := 0
+	increment := func() {
+		counter++
+		db.AppendJournalEntry(counterChange{&counter})
+	}
+
+	increment()
+	snapshot := db.Snapshot()
+	increment()
+	increment()
+	suite.Require().Equal(3, counter)
+
+	db.RevertToSnapshot(snapshot)
+	suite.Require().Equal(1, counter)
+	suite.Require().NoError(db.Commit())
+}
+
 func CollectContractStorage(db vm.StateDB) statedb.Storage {
 	storage := make(statedb.Storage)
 	db.ForEachStorage(address, func(k, v common.Hash) bool {
@@ -0,0 +1,85 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+
+// Package tracers implements the native go tracers of the EVM module: the
+// `callTracer`, `prestateTracer` and `4byteTracer`. Their results follow the
+// format of the go-ethereum native tracers of the same name, including the
+// `withLog` and `diffMode` options which are missing from the go-ethereum
+// version the module is built on.
+//
+// Unlike go-ethereum, the EVM state transition of the module neither buys the
+// gas nor increments the sender nonce of calls, both are done by the ante
+// handler. The tracers account for it so the reported states are the ones of
+// the chain before and after the transaction.
+package tracers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	gethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+// memoryPadLimit is the maximum size of the zero padding added to a memory slice
+// read out of bounds.
+const memoryPadLimit = 1024 * 1024
+
+// ctorFn is the constructor signature of a native tracer.
+type ctorFn func(*gethtracers.Context, json.RawMessage) (gethtracers.Tracer, error)
+
+// ctors are the native tracer constructors indexed by tracer name.
+var ctors = map[string]ctorFn{
+	"callTracer":     newCallTracer,
+	"prestateTracer": newPrestateTracer,
+	"4byteTracer":    newFourByteTracer,
+}
+
+// New returns the tracer registered under the given name with the json-encoded
+// tracer config. The native tracers of this package take precedence over the
+// ones registered in go-ethereum, which are used for any other name (eg. JS
+// tracers).
+func New(name string, ctx *gethtracers.Context, cfg json.RawMessage) (gethtracers.Tracer, error) {
+	if ctor, ok := ctors[name]; ok {
+		tracer, err := ctor(ctx, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s config: %w", name, err)
+		}
+		return tracer, nil
+	}
+	return gethtracers.New(name, ctx, cfg)
+}
+
+// getMemoryCopyPadded returns a copy of the memory slice, padded with zeros if
+// the slice is (partially) out of the memory bounds.
+func getMemoryCopyPadded(m *vm.Memory, offset, size int64) ([]byte, error) {
+	if offset < 0 || size < 0 {
+		return nil, errors.New("offset or size must not be negative")
+	}
+	if int(offset+size) < m.Len() { // slice fully inside memory
+		return m.GetCopy(offset, size), nil
+	}
+	paddingNeeded := int(offset+size) - m.Len()
+	if paddingNeeded > memoryPadLimit {
+		return nil, fmt.Errorf("reached limit for padding memory slice: %d", paddingNeeded)
+	}
+	cpy := make([]byte, size)
+	if overlap := int64(m.Len()) - offset; overlap > 0 {
+		copy(cpy, m.GetPtr(offset, overlap))
+	}
+	return cpy, nil
+}
@@ -0,0 +1,312 @@
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	gethstate "github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	gethtracers "github.com/ethereum/go-ethereum/eth/tracers"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/tests"
+	"github.com/stretchr/testify/require"
+)
+
+// The fixtures in testdata/call_tracer are the go-ethereum callTracer test
+// suite, their genesis allocs were produced by the go-ethereum prestateTracer.
+//
+// The fixtures in testdata/prestate_tracer and
+// testdata/prestate_tracer_with_diff_mode are the go-ethereum v1.11.6
+// prestateTracer test suite, unchanged. They diverge from the EVM module on
+// the coinbase, which doesn't receive the fees of the tx:
+//   - prestate_tracer: none, the coinbase is looked up before the tx so its
+//     prestate is the same.
+//   - prestate_tracer_with_diff_mode (all the cases): the coinbase balance
+//     isn't modified, so it's left out of the expected pre and post states.
+//
+// go-ethereum has no 4byteTracer fixtures, the 4byteTracer is checked against
+// the go-ethereum native 4byteTracer on the txs of testdata/call_tracer.
+
+type callContext struct {
+	Number     math.HexOrDecimal64   `json:"number"`
+	Difficulty *math.HexOrDecimal256 `json:"difficulty"`
+	Time       math.HexOrDecimal64   `json:"timestamp"`
+	GasLimit   math.HexOrDecimal64   `json:"gasLimit"`
+	Miner      common.Address        `json:"miner"`
+}
+
+// tracerTest defines a single test to check a tracer against.
+type tracerTest struct {
+	Genesis      *core.Genesis   `json:"genesis"`
+	Context      *callContext    `json:"context"`
+	Input        string          `json:"input"`
+	TracerConfig json.RawMessage `json:"tracerConfig"`
+	Result       json.RawMessage `json:"result"`
+}
+
+// callTrace is the decoded result of a callTracer run.
+type callTrace struct {
+	Type   string          `json:"type"`
+	From   common.Address  `json:"from"`
+	To     common.Address  `json:"to"`
+	Input  hexutil.Bytes   `json:"input"`
+	Output hexutil.Bytes   `json:"output"`
+	Error  string          `json:"error"`
+	Calls  []callTrace     `json:"calls"`
+	Logs   []callTraceLog  `json:"logs"`
+	Value  *hexutil.Big    `json:"value"`
+	Gas    *hexutil.Uint64 `json:"gas"`
+}
+
+type callTraceLog struct {
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+func loadTests(t *testing.T, dir string) map[string]*tracerTest {
+	files, err := filepath.Glob(filepath.Join("testdata", dir, "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	testCases := make(map[string]*tracerTest, len(files))
+	for _, file := range files {
+		blob, err := os.ReadFile(file)
+		require.NoError(t, err)
+		test := new(tracerTest)
+		require.NoError(t, json.Unmarshal(blob, test), file)
+		testCases[strings.TrimSuffix(filepath.Base(file), ".json")] = test
+	}
+	return testCases
+}
+
+func (test *tracerTest) tx(t *testing.T) (*types.Transaction, types.Signer) {
+	tx := new(types.Transaction)
+	require.NoError(t, tx.UnmarshalBinary(common.FromHex(test.Input)))
+	return tx, types.MakeSigner(test.Genesis.Config, new(big.Int).SetUint64(uint64(test.Context.Number)))
+}
+
+func (test *tracerTest) newEVM(t *testing.T, tracer vm.EVMLogger) (*vm.EVM, core.Message) {
+	tx, signer := test.tx(t)
+	msg, err := tx.AsMessage(signer, nil)
+	require.NoError(t, err)
+
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		Coinbase:    test.Context.Miner,
+		BlockNumber: new(big.Int).SetUint64(uint64(test.Context.Number)),
+		Time:        new(big.Int).SetUint64(uint64(test.Context.Time)),
+		Difficulty:  (*big.Int)(test.Context.Difficulty),
+		GasLimit:    uint64(test.Context.GasLimit),
+	}
+	txCtx := vm.TxContext{Origin: msg.From(), GasPrice: tx.GasPrice()}
+	_, statedb := tests.MakePreState(rawdb.NewMemoryDatabase(), test.Genesis.Alloc, false)
+
+	vmCfg := vm.Config{}
+	if tracer != nil {
+		vmCfg = vm.Config{Debug: true, Tracer: tracer}
+	}
+	return vm.NewEVM(blockCtx, txCtx, statedb, test.Genesis.Config, vmCfg), msg
+}
+
+// applyMessage executes the test tx the way the EVM module state transition
+// does: the gas isn't bought and the nonce of calls isn't incremented.
+func (test *tracerTest) applyMessage(t *testing.T, tracer vm.EVMLogger) *gethstate.StateDB {
+	evm, msg := test.newEVM(t, tracer)
+	statedb := evm.StateDB.(*gethstate.StateDB)
+	rules := evm.ChainConfig().Rules(evm.Context.BlockNumber, false)
+	contractCreation := msg.To() == nil
+
+	leftoverGas := msg.Gas()
+	tracer.CaptureTxStart(leftoverGas)
+	defer func() {
+		tracer.CaptureTxEnd(leftoverGas)
+	}()
+
+	intrinsicGas, err := core.IntrinsicGas(msg.Data(), msg.AccessList(), contractCreation, rules.IsHomestead, rules.IsIstanbul)
+	require.NoError(t, err)
+	leftoverGas -= intrinsicGas
+
+	if rules.IsBerlin {
+		statedb.PrepareAccessList(msg.From(), msg.To(), vm.ActivePrecompiles(rules), msg.AccessList())
+	}
+
+	sender := vm.AccountRef(msg.From())
+	if contractCreation {
+		statedb.SetNonce(sender.Address(), msg.Nonce())
+		_, _, leftoverGas, _ = evm.Create(sender, msg.Data(), leftoverGas, msg.Value())
+		statedb.SetNonce(sender.Address(), msg.Nonce()+1)
+	} else {
+		_, leftoverGas, _ = evm.Call(sender, *msg.To(), msg.Data(), leftoverGas, msg.Value())
+	}
+
+	refundQuotient := params.RefundQuotient
+	if rules.IsLondon {
+		refundQuotient = params.RefundQuotientEIP3529
+	}
+	gasUsed := msg.Gas() - leftoverGas
+	refund := gasUsed / refundQuotient
+	if refund > statedb.GetRefund() {
+		refund = statedb.GetRefund()
+	}
+	leftoverGas += refund
+	return statedb
+}
+
+func (test *tracerTest) trace(t *testing.T, name string, cfg json.RawMessage) (json.RawMessage, *gethstate.StateDB) {
+	tracer, err := New(name, new(gethtracers.Context), cfg)
+	require.NoError(t, err)
+	statedb := test.applyMessage(t, tracer)
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	return res, statedb
+}
+
+func TestNew(t *testing.T) {
+	for name := range ctors {
+		tracer, err := New(name, new(gethtracers.Context), nil)
+		require.NoError(t, err)
+		require.NotNil(t, tracer)
+	}
+
+	_, err := New("callTracer", new(gethtracers.Context), json.RawMessage(`{"onlyTopCall":"yes"}`))
+	require.Error(t, err)
+
+	_, err = New("unknownTracer", new(gethtracers.Context), nil)
+	require.Error(t, err)
+}
+
+func TestCallTracer(t *testing.T) {
+	for name, test := range loadTests(t, "call_tracer") {
+		t.Run(name, func(t *testing.T) {
+			res, _ := test.trace(t, "callTracer", test.TracerConfig)
+			require.JSONEq(t, string(test.Result), string(res))
+		})
+	}
+}
+
+func TestCallTracerWithLog(t *testing.T) {
+	var logsFound bool
+	for name, test := range loadTests(t, "call_tracer") {
+		t.Run(name, func(t *testing.T) {
+			cfg := map[string]interface{}{"withLog": true}
+			if test.TracerConfig != nil {
+				require.NoError(t, json.Unmarshal(test.TracerConfig, &cfg))
+				cfg["withLog"] = true
+			}
+			bz, err := json.Marshal(cfg)
+			require.NoError(t, err)
+
+			res, statedb := test.trace(t, "callTracer", bz)
+			var trace callTrace
+			require.NoError(t, json.Unmarshal(res, &trace))
+
+			// apart from the logs, the trace is the one without logs
+			var withoutLogs map[string]interface{}
+			require.NoError(t, json.Unmarshal(res, &withoutLogs))
+			removeLogs(withoutLogs)
+			bz, err = json.Marshal(withoutLogs)
+			require.NoError(t, err)
+			require.JSONEq(t, string(test.Result), string(bz))
+
+			if cfg["onlyTopCall"] == true {
+				return
+			}
+			// the logs of the calls are the ones emitted by the tx
+			var expLogs []callTraceLog
+			for _, log := range statedb.Logs() {
+				expLogs = append(expLogs, callTraceLog{Address: log.Address, Topics: log.Topics, Data: log.Data})
+			}
+			logs := collectLogs(trace)
+			require.Equal(t, sortLogs(expLogs), sortLogs(logs))
+			logsFound = logsFound || len(logs) > 0
+		})
+	}
+	require.True(t, logsFound)
+}
+
+func TestPrestateTracer(t *testing.T) {
+	for name, test := range loadTests(t, "prestate_tracer") {
+		t.Run(name, func(t *testing.T) {
+			res, _ := test.trace(t, "prestateTracer", test.TracerConfig)
+			require.JSONEq(t, string(test.Result), string(res))
+		})
+	}
+}
+
+func TestPrestateTracerDiffMode(t *testing.T) {
+	for name, test := range loadTests(t, "prestate_tracer_with_diff_mode") {
+		t.Run(name, func(t *testing.T) {
+			res, _ := test.trace(t, "prestateTracer", test.TracerConfig)
+
+			// the coinbase doesn't receive the fees in the EVM module
+			var exp map[string]map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(test.Result, &exp))
+			coinbase := strings.ToLower(test.Context.Miner.Hex())
+			require.Contains(t, exp["post"], coinbase)
+			delete(exp["pre"], coinbase)
+			delete(exp["post"], coinbase)
+			bz, err := json.Marshal(exp)
+			require.NoError(t, err)
+			require.JSONEq(t, string(bz), string(res))
+		})
+	}
+}
+
+func TestFourByteTracer(t *testing.T) {
+	var idsFound bool
+	for name, test := range loadTests(t, "call_tracer") {
+		t.Run(name, func(t *testing.T) {
+			gethTracer, err := gethtracers.New("4byteTracer", new(gethtracers.Context), nil)
+			require.NoError(t, err)
+			test.applyMessage(t, gethTracer)
+			exp, err := gethTracer.GetResult()
+			require.NoError(t, err)
+
+			res, _ := test.trace(t, "4byteTracer", nil)
+			require.JSONEq(t, string(exp), string(res))
+			idsFound = idsFound || string(res) != "{}"
+		})
+	}
+	require.True(t, idsFound)
+}
+
+func removeLogs(frame map[string]interface{}) {
+	delete(frame, "logs")
+	if calls, ok := frame["calls"].([]interface{}); ok {
+		for _, call := range calls {
+			removeLogs(call.(map[string]interface{}))
+		}
+	}
+}
+
+func collectLogs(frame callTrace) []callTraceLog {
+	logs := frame.Logs
+	for _, call := range frame.Calls {
+		logs = append(logs, collectLogs(call)...)
+	}
+	return logs
+}
+
+func sortLogs(logs []callTraceLog) []string {
+	res := make([]string, len(logs))
+	for i, log := range logs {
+		bz, _ := json.Marshal(log)
+		res[i] = string(bz)
+	}
+	sort.Strings(res)
+	return res
+}
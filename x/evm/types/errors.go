@@ -49,9 +49,15 @@ const (
 	codeErrGasOverflow
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
+	codeErrDeployerNotAllowed
+	codeErrContractBlocked
 )
 
-var ErrPostTxProcessing = errors.New("failed to execute post processing")
+var (
+	ErrPreTxProcessing  = errors.New("failed to execute pre processing")
+	ErrPostTxProcessing = errors.New("failed to execute post processing")
+	ErrCallProcessing   = errors.New("failed to execute call processing")
+)
 
 var (
 	// ErrInvalidState returns an error resulting from an invalid Storage State.
@@ -116,6 +122,12 @@ var (
 
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
+
+	// ErrDeployerNotAllowed returns an error if the deployer isn't in the AllowedDeployers parameter.
+	ErrDeployerNotAllowed = errorsmod.Register(ModuleName, codeErrDeployerNotAllowed, "deployer is not allowed to create contracts")
+
+	// ErrContractBlocked returns an error if the contract is in the BlockedContracts parameter.
+	ErrContractBlocked = errorsmod.Register(ModuleName, codeErrContractBlocked, "contract is blocked")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
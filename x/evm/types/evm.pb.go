@@ -47,6 +47,14 @@ type Params struct {
 	// enabled_precompiles should be sorted in ascending order and unique.
 	// sorting and uniqueness are checked against bytes representation of addresses
 	EnabledPrecompiles []string `protobuf:"bytes,8,rep,name=enabled_precompiles,json=enabledPrecompiles,proto3" json:"enabled_precompiles,omitempty"`
+	// allowed_deployers contains list of hex-encoded evm addresses allowed to create contracts,
+	// including the contracts created by other contracts. Any address can create contracts if it's empty.
+	// allowed_deployers should be sorted in ascending order and unique.
+	AllowedDeployers []string `protobuf:"bytes,9,rep,name=allowed_deployers,json=allowedDeployers,proto3" json:"allowed_deployers,omitempty"`
+	// blocked_contracts contains list of hex-encoded evm addresses of contracts which can't be called,
+	// directly or by other contracts.
+	// blocked_contracts should be sorted in ascending order and unique.
+	BlockedContracts []string `protobuf:"bytes,10,rep,name=blocked_contracts,json=blockedContracts,proto3" json:"blocked_contracts,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -138,6 +146,20 @@ func (m *Params) GetEnabledPrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetAllowedDeployers() []string {
+	if m != nil {
+		return m.AllowedDeployers
+	}
+	return nil
+}
+
+func (m *Params) GetBlockedContracts() []string {
+	if m != nil {
+		return m.BlockedContracts
+	}
+	return nil
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -896,122 +918,124 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1838 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x6e, 0x1b, 0xb9,
-	0x15, 0x8e, 0xad, 0xb1, 0x3d, 0xa2, 0x64, 0x69, 0x4c, 0x29, 0x5e, 0x25, 0x41, 0x3d, 0xee, 0x14,
-	0x2d, 0x5c, 0x60, 0x63, 0xaf, 0xbd, 0x30, 0x12, 0x6c, 0xd0, 0x1f, 0xcb, 0xf1, 0xee, 0xda, 0x4d,
-	0x5c, 0x83, 0x71, 0x5a, 0xa0, 0x40, 0x31, 0xa0, 0x66, 0xb8, 0xa3, 0x89, 0x67, 0x86, 0x02, 0xc9,
-	0x51, 0xa4, 0xb6, 0x0f, 0x50, 0xa0, 0x37, 0x7d, 0x82, 0x62, 0x6f, 0xfb, 0x26, 0x8b, 0x5e, 0xed,
-	0x65, 0xd1, 0x8b, 0x41, 0xe1, 0xdc, 0xf9, 0xd2, 0x2f, 0xd0, 0x82, 0x3f, 0xfa, 0xb5, 0xb7, 0x5d,
-	0xfb, 0x4a, 0x3c, 0x3f, 0xfc, 0x3e, 0x9e, 0xc3, 0xc3, 0x39, 0xa4, 0xc0, 0x63, 0x22, 0xba, 0x84,
-	0xa5, 0x71, 0x26, 0x76, 0x48, 0x3f, 0xdd, 0xe9, 0xef, 0xca, 0x9f, 0xed, 0x1e, 0xa3, 0x82, 0x42,
-	0x67, 0x6c, 0xdb, 0x96, 0xca, 0xfe, 0xee, 0xe3, 0x66, 0x44, 0x23, 0xaa, 0x8c, 0x3b, 0x72, 0xa4,
-	0xfd, 0xbc, 0xbf, 0x5b, 0x60, 0xf9, 0x0c, 0x33, 0x9c, 0x72, 0xb8, 0x0b, 0xca, 0xa4, 0x9f, 0xfa,
-	0x21, 0xc9, 0x68, 0xda, 0x5a, 0xd8, 0x5c, 0xd8, 0x2a, 0xb7, 0x9b, 0xd7, 0x85, 0xeb, 0x0c, 0x71,
-	0x9a, 0x7c, 0xe6, 0x8d, 0x4d, 0x1e, 0xb2, 0x49, 0x3f, 0x7d, 0x29, 0x87, 0xf0, 0x67, 0x60, 0x95,
-	0x64, 0xb8, 0x93, 0x10, 0x3f, 0x60, 0x04, 0x0b, 0xd2, 0x5a, 0xdc, 0x5c, 0xd8, 0xb2, 0xdb, 0xad,
-	0xeb, 0xc2, 0x6d, 0x9a, 0x69, 0xd3, 0x66, 0x0f, 0x55, 0xb5, 0x7c, 0xa8, 0x44, 0xf8, 0x0c, 0x54,
-	0x46, 0x76, 0x9c, 0x24, 0xad, 0x92, 0x9a, 0xbc, 0x7e, 0x5d, 0xb8, 0x70, 0x76, 0x32, 0x4e, 0x12,
-	0x0f, 0x01, 0x33, 0x15, 0x27, 0x09, 0x3c, 0x00, 0x80, 0x0c, 0x04, 0xc3, 0x3e, 0x89, 0x7b, 0xbc,
-	0x65, 0x6d, 0x96, 0xb6, 0x4a, 0x6d, 0xef, 0xb2, 0x70, 0xcb, 0x47, 0x52, 0x7b, 0x74, 0x7c, 0xc6,
-	0xaf, 0x0b, 0x77, 0xcd, 0x80, 0x8c, 0x1d, 0x3d, 0x54, 0x56, 0xc2, 0x51, 0xdc, 0xe3, 0xf0, 0xf7,
-	0xa0, 0x1a, 0x74, 0x71, 0x9c, 0xf9, 0x01, 0xcd, 0xbe, 0x8a, 0xa3, 0xd6, 0xd2, 0xe6, 0xc2, 0x56,
-	0x65, 0xef, 0x07, 0xdb, 0xf3, 0x79, 0xdb, 0x3e, 0x94, 0x5e, 0x87, 0xca, 0xa9, 0xfd, 0xe4, 0x9b,
-	0xc2, 0x7d, 0x70, 0x5d, 0xb8, 0x0d, 0x0d, 0x3d, 0x0d, 0xe0, 0xa1, 0x4a, 0x30, 0xf1, 0x84, 0x29,
-	0x68, 0x90, 0xb8, 0xf7, 0x6c, 0x77, 0xcf, 0xc7, 0x49, 0x42, 0xdf, 0x93, 0xd0, 0x4f, 0x79, 0xc4,
-	0x5b, 0xcb, 0x9b, 0xa5, 0xad, 0xca, 0x9e, 0x77, 0x93, 0xe5, 0xe8, 0xf8, 0xec, 0xd9, 0xee, 0xde,
-	0x81, 0xf6, 0x7d, 0xcd, 0xa3, 0xf6, 0x23, 0x49, 0x75, 0x59, 0xb8, 0x6b, 0xf3, 0x16, 0x8e, 0xd6,
-	0x34, 0xf2, 0x94, 0x0a, 0xee, 0x81, 0x87, 0x8a, 0xc7, 0xcf, 0x33, 0xb9, 0xaf, 0x24, 0x10, 0x24,
-	0xf4, 0xc5, 0x80, 0xb7, 0x56, 0x64, 0x4e, 0x51, 0x43, 0x19, 0xdf, 0x4e, 0x6c, 0xe7, 0x03, 0x0e,
-	0x77, 0x40, 0x43, 0xa7, 0x34, 0xf4, 0x7b, 0x8c, 0x04, 0x34, 0xed, 0xc5, 0x09, 0xe1, 0x2d, 0x7b,
-	0xb3, 0xb4, 0x55, 0x46, 0xd0, 0x98, 0xce, 0x26, 0x16, 0xef, 0x6f, 0x6b, 0xa0, 0x72, 0x38, 0x13,
-	0x63, 0xbd, 0x4b, 0x53, 0xc2, 0x05, 0xc1, 0xa1, 0xdf, 0x49, 0x68, 0x70, 0x61, 0xca, 0xe6, 0xe5,
-	0xbf, 0x0a, 0xf7, 0x27, 0x51, 0x2c, 0xba, 0x79, 0x67, 0x3b, 0xa0, 0xe9, 0x4e, 0x40, 0x79, 0x4a,
-	0xb9, 0xf9, 0x79, 0xca, 0xc3, 0x8b, 0x1d, 0x31, 0xec, 0x11, 0xbe, 0x7d, 0x9c, 0x89, 0xeb, 0xc2,
-	0x5d, 0xd7, 0xc9, 0x9c, 0x83, 0xf2, 0x50, 0x6d, 0xac, 0x69, 0x4b, 0x05, 0x1c, 0x82, 0x5a, 0x88,
-	0xa9, 0xff, 0x15, 0x65, 0x17, 0x86, 0x6d, 0x51, 0xb1, 0xbd, 0xf9, 0xfe, 0x6c, 0x97, 0x85, 0x5b,
-	0x7d, 0x79, 0xf0, 0xeb, 0xcf, 0x29, 0xbb, 0x50, 0x98, 0xd7, 0x85, 0xfb, 0x50, 0xb3, 0xcf, 0x22,
-	0x7b, 0xa8, 0x1a, 0x62, 0x3a, 0x76, 0x83, 0xbf, 0x05, 0xce, 0xd8, 0x81, 0xe7, 0xbd, 0x1e, 0x65,
-	0xc2, 0x54, 0xeb, 0xd3, 0xcb, 0xc2, 0xad, 0x19, 0xc8, 0x37, 0xda, 0x72, 0x5d, 0xb8, 0x1f, 0xcd,
-	0x81, 0x9a, 0x39, 0x1e, 0xaa, 0x19, 0x58, 0xe3, 0x0a, 0x39, 0xa8, 0x92, 0xb8, 0xb7, 0xbb, 0xff,
-	0x89, 0x89, 0xc8, 0x52, 0x11, 0x9d, 0xdd, 0x29, 0xa2, 0xca, 0xd1, 0xf1, 0xd9, 0xee, 0xfe, 0x27,
-	0xa3, 0x80, 0x4c, 0x6d, 0x4e, 0xc3, 0x7a, 0xa8, 0xa2, 0x45, 0x1d, 0xcd, 0x31, 0x30, 0xa2, 0xdf,
-	0xc5, 0xbc, 0xab, 0x2a, 0xbf, 0xdc, 0xde, 0xba, 0x2c, 0x5c, 0xa0, 0x91, 0xbe, 0xc4, 0xbc, 0x3b,
-	0xd9, 0x97, 0xce, 0xf0, 0x0f, 0x38, 0x13, 0x71, 0x9e, 0x8e, 0xb0, 0x80, 0x9e, 0x2c, 0xbd, 0xc6,
-	0xeb, 0xdf, 0x37, 0xeb, 0x5f, 0xbe, 0xf7, 0xfa, 0xf7, 0x6f, 0x5b, 0xff, 0xfe, 0xec, 0xfa, 0xb5,
-	0xcf, 0x98, 0xf4, 0xb9, 0x21, 0x5d, 0xb9, 0x37, 0xe9, 0xf3, 0xdb, 0x48, 0x9f, 0xcf, 0x92, 0x6a,
-	0x1f, 0x59, 0xec, 0x73, 0x99, 0x68, 0xd9, 0xf7, 0x2f, 0xf6, 0x1b, 0x49, 0xad, 0x8d, 0x35, 0x9a,
-	0xee, 0x4f, 0xa0, 0x19, 0xd0, 0x8c, 0x0b, 0xa9, 0xcb, 0x68, 0x2f, 0x21, 0x86, 0xb3, 0xac, 0x38,
-	0x8f, 0xef, 0xc4, 0xf9, 0xc4, 0x7c, 0xad, 0x6e, 0xc1, 0xf3, 0x50, 0x63, 0x56, 0xad, 0xd9, 0x7b,
-	0xc0, 0xe9, 0x11, 0x41, 0x18, 0xef, 0xe4, 0x2c, 0x32, 0xcc, 0x40, 0x31, 0x1f, 0xdd, 0x89, 0xd9,
-	0x9c, 0x83, 0x79, 0x2c, 0x0f, 0xd5, 0x27, 0x2a, 0xcd, 0xf8, 0x0e, 0xd4, 0x62, 0xb9, 0x8c, 0x4e,
-	0x9e, 0x18, 0xbe, 0x8a, 0xe2, 0x3b, 0xbc, 0x13, 0x9f, 0x39, 0xcc, 0xb3, 0x48, 0x1e, 0x5a, 0x1d,
-	0x29, 0x34, 0x57, 0x0e, 0x60, 0x9a, 0xc7, 0xcc, 0x8f, 0x12, 0x1c, 0xc4, 0x84, 0x19, 0xbe, 0xaa,
-	0xe2, 0xfb, 0xe2, 0x4e, 0x7c, 0x8f, 0x34, 0xdf, 0x4d, 0x34, 0x0f, 0x39, 0x52, 0xf9, 0x85, 0xd6,
-	0x69, 0xda, 0x10, 0x54, 0x3b, 0x84, 0x25, 0x71, 0x66, 0x08, 0x57, 0x15, 0xe1, 0xc1, 0x9d, 0x08,
-	0x4d, 0x9d, 0x4e, 0xe3, 0x78, 0xa8, 0xa2, 0xc5, 0x31, 0x4b, 0x42, 0xb3, 0x90, 0x8e, 0x58, 0xd6,
-	0xee, 0xcf, 0x32, 0x8d, 0xe3, 0xa1, 0x8a, 0x16, 0x35, 0xcb, 0x00, 0x34, 0x30, 0x63, 0xf4, 0xfd,
-	0x5c, 0x0e, 0xa1, 0x22, 0xfb, 0xf2, 0x4e, 0x64, 0x8f, 0x35, 0xd9, 0x2d, 0x70, 0x1e, 0x5a, 0x53,
-	0xda, 0x99, 0x2c, 0xe6, 0x00, 0x46, 0x0c, 0x0f, 0xe7, 0x88, 0x9b, 0xf7, 0xdf, 0xbc, 0x9b, 0x68,
-	0x1e, 0x72, 0xa4, 0x72, 0x86, 0xf6, 0x8f, 0xa0, 0x99, 0x12, 0x16, 0x11, 0x3f, 0x23, 0x82, 0xf7,
-	0x92, 0x58, 0x18, 0xe2, 0x87, 0xf7, 0x3f, 0x8f, 0xb7, 0xe1, 0x79, 0x08, 0x2a, 0xf5, 0xa9, 0xd1,
-	0x8e, 0x0f, 0x07, 0xef, 0xe2, 0x2c, 0xea, 0xe2, 0xd8, 0xd0, 0xae, 0xdf, 0xff, 0x70, 0xcc, 0x22,
-	0x79, 0x68, 0x75, 0xa4, 0x18, 0xd7, 0x4f, 0x80, 0xb3, 0x20, 0x1f, 0xd5, 0xcf, 0x47, 0xf7, 0xaf,
-	0x9f, 0x69, 0x1c, 0x79, 0x3d, 0x52, 0xa2, 0x62, 0x39, 0xb1, 0xec, 0x9a, 0x53, 0x3f, 0xb1, 0xec,
-	0xba, 0xe3, 0x9c, 0x58, 0xb6, 0xe3, 0xac, 0x9d, 0x58, 0x76, 0xc3, 0x69, 0xa2, 0xd5, 0x21, 0x4d,
-	0xa8, 0xdf, 0xff, 0x54, 0x4f, 0x42, 0x15, 0xf2, 0x1e, 0x73, 0xf3, 0x8d, 0x44, 0xb5, 0x00, 0x0b,
-	0x9c, 0x0c, 0xb9, 0x49, 0x15, 0x72, 0x74, 0x02, 0xa7, 0xba, 0xf6, 0x0e, 0x58, 0x7a, 0x23, 0xe4,
-	0xc5, 0xd2, 0x01, 0xa5, 0x0b, 0x32, 0xd4, 0xb7, 0x11, 0x24, 0x87, 0xb0, 0x09, 0x96, 0xfa, 0x38,
-	0xc9, 0xf5, 0x0d, 0xb5, 0x8c, 0xb4, 0xe0, 0x9d, 0x81, 0xfa, 0x39, 0xc3, 0x19, 0xc7, 0x81, 0x88,
-	0x69, 0xf6, 0x8a, 0x46, 0x1c, 0x42, 0x60, 0xa9, 0xae, 0xa8, 0xe7, 0xaa, 0x31, 0xfc, 0x29, 0xb0,
-	0x12, 0x1a, 0xf1, 0xd6, 0xa2, 0xba, 0xbd, 0x3d, 0xbc, 0x79, 0x7b, 0x7b, 0x45, 0x23, 0xa4, 0x5c,
-	0xbc, 0x7f, 0x2c, 0x82, 0xd2, 0x2b, 0x1a, 0xc1, 0x16, 0x58, 0xc1, 0x61, 0xc8, 0x08, 0xe7, 0x06,
-	0x69, 0x24, 0xc2, 0x75, 0xb0, 0x2c, 0x68, 0x2f, 0x0e, 0x34, 0x5c, 0x19, 0x19, 0x49, 0x12, 0x87,
-	0x58, 0x60, 0x75, 0xaf, 0xa8, 0x22, 0x35, 0x86, 0x7b, 0xa0, 0xaa, 0x22, 0xf3, 0xb3, 0x3c, 0xed,
-	0x10, 0xa6, 0xae, 0x07, 0x56, 0xbb, 0x7e, 0x55, 0xb8, 0x15, 0xa5, 0x3f, 0x55, 0x6a, 0x34, 0x2d,
-	0xc0, 0x8f, 0xc1, 0x8a, 0x18, 0x4c, 0x77, 0xf6, 0xc6, 0x55, 0xe1, 0xd6, 0xc5, 0x24, 0x4c, 0xd9,
-	0xb8, 0xd1, 0xb2, 0x18, 0xa8, 0x06, 0xbe, 0x03, 0x6c, 0x31, 0xf0, 0xe3, 0x2c, 0x24, 0x03, 0xd5,
-	0xbc, 0xad, 0x76, 0xf3, 0xaa, 0x70, 0x9d, 0x29, 0xf7, 0x63, 0x69, 0x43, 0x2b, 0x62, 0xa0, 0x06,
-	0xf0, 0x63, 0x00, 0xf4, 0x92, 0x14, 0x83, 0x6e, 0xbd, 0xab, 0x57, 0x85, 0x5b, 0x56, 0x5a, 0x85,
-	0x3d, 0x19, 0x42, 0x0f, 0x2c, 0x69, 0x6c, 0x5b, 0x61, 0x57, 0xaf, 0x0a, 0xd7, 0x4e, 0x68, 0xa4,
-	0x31, 0xb5, 0x49, 0xa6, 0x8a, 0x91, 0x94, 0xf6, 0x49, 0xa8, 0xba, 0x9b, 0x8d, 0x46, 0xa2, 0xf7,
-	0x97, 0x45, 0x60, 0x9f, 0x0f, 0x10, 0xe1, 0x79, 0x22, 0xe0, 0xe7, 0xc0, 0x09, 0x68, 0x26, 0x18,
-	0x0e, 0x84, 0x3f, 0x93, 0xda, 0xf6, 0x93, 0x49, 0xa7, 0x99, 0xf7, 0xf0, 0x50, 0x7d, 0xa4, 0x3a,
-	0x30, 0xf9, 0x6f, 0x82, 0xa5, 0x4e, 0x42, 0x69, 0xaa, 0x2a, 0xa1, 0x8a, 0xb4, 0x00, 0x91, 0xca,
-	0x9a, 0xda, 0xe5, 0x92, 0x7a, 0x09, 0xfc, 0xf0, 0xe6, 0x2e, 0xcf, 0x95, 0x4a, 0x7b, 0xdd, 0xbc,
-	0x06, 0x6a, 0x9a, 0xdb, 0xcc, 0xf7, 0x64, 0x6e, 0x55, 0x29, 0x39, 0xa0, 0xc4, 0x88, 0x50, 0x9b,
-	0x56, 0x45, 0x72, 0x08, 0x1f, 0x03, 0x9b, 0x91, 0x3e, 0x61, 0x82, 0x84, 0x6a, 0x73, 0x6c, 0x34,
-	0x96, 0xe1, 0x23, 0x60, 0x47, 0x98, 0xfb, 0x39, 0x27, 0xa1, 0xde, 0x09, 0xb4, 0x12, 0x61, 0xfe,
-	0x96, 0x93, 0xf0, 0x33, 0xeb, 0xcf, 0x5f, 0xbb, 0x0f, 0x3c, 0x0c, 0x2a, 0x07, 0x41, 0x40, 0x38,
-	0x3f, 0xcf, 0x7b, 0x09, 0xf9, 0x1f, 0x15, 0xb6, 0x07, 0xaa, 0x5c, 0x50, 0x86, 0x23, 0xe2, 0x5f,
-	0x90, 0xa1, 0xa9, 0x33, 0x5d, 0x35, 0x46, 0xff, 0x2b, 0x32, 0xe4, 0x68, 0x5a, 0x30, 0x14, 0x5f,
-	0x5b, 0xa0, 0x72, 0xce, 0x70, 0x40, 0xcc, 0x0d, 0x5f, 0xd6, 0xaa, 0x14, 0x99, 0xa1, 0x30, 0x92,
-	0xe4, 0x16, 0x71, 0x4a, 0x68, 0x2e, 0xcc, 0x79, 0x1a, 0x89, 0x72, 0x06, 0x23, 0x64, 0x40, 0x02,
-	0x95, 0x46, 0x0b, 0x19, 0x09, 0xee, 0x83, 0xd5, 0x30, 0xe6, 0xea, 0x39, 0xc7, 0x05, 0x0e, 0x2e,
-	0x74, 0xf8, 0x6d, 0xe7, 0xaa, 0x70, 0xab, 0xc6, 0xf0, 0x46, 0xea, 0xd1, 0x8c, 0x04, 0x5f, 0x80,
-	0xfa, 0x64, 0x9a, 0x5a, 0xad, 0xca, 0x8d, 0xdd, 0x86, 0x57, 0x85, 0x5b, 0x1b, 0xbb, 0x2a, 0x0b,
-	0x9a, 0x93, 0xe5, 0x4e, 0x87, 0xa4, 0x93, 0x47, 0xaa, 0xf8, 0x6c, 0xa4, 0x05, 0xa9, 0x4d, 0xe2,
-	0x34, 0x16, 0xaa, 0xd8, 0x96, 0x90, 0x16, 0xe0, 0x0b, 0x50, 0xa6, 0x7d, 0xc2, 0x58, 0x1c, 0x12,
-	0xae, 0xae, 0x3a, 0xff, 0xef, 0x2d, 0x88, 0x26, 0xfe, 0x32, 0x38, 0xf3, 0x54, 0x4d, 0x49, 0x4a,
-	0xd9, 0x50, 0xdd, 0x5d, 0x4c, 0x70, 0xda, 0xf0, 0x5a, 0xe9, 0xd1, 0x8c, 0x04, 0xdb, 0xc0, 0xbc,
-	0xb2, 0x7c, 0x46, 0x44, 0xce, 0x32, 0x5f, 0x9d, 0xff, 0xaa, 0x9a, 0xab, 0x4e, 0xa1, 0xb6, 0x22,
-	0x65, 0x7c, 0x89, 0x05, 0x46, 0x37, 0x34, 0xf0, 0xe7, 0x00, 0xea, 0x3d, 0xf1, 0xdf, 0x71, 0x3a,
-	0x7e, 0xcc, 0xea, 0xab, 0x85, 0xe2, 0xd7, 0x56, 0xb3, 0x66, 0x47, 0x4b, 0x27, 0x9c, 0x9a, 0x28,
-	0x4e, 0x2c, 0xdb, 0x72, 0x96, 0x4e, 0x2c, 0x7b, 0xc5, 0xb1, 0xc7, 0xf9, 0x33, 0x51, 0xa0, 0xc6,
-	0x48, 0x9e, 0x5a, 0x9e, 0xf7, 0x9f, 0x05, 0xe0, 0xcc, 0x3f, 0x49, 0xe1, 0x26, 0xa8, 0xa6, 0x3c,
-	0xf2, 0x65, 0x0f, 0xf0, 0x73, 0x96, 0x98, 0x6a, 0x01, 0x29, 0x8f, 0xce, 0x87, 0x3d, 0xf2, 0x96,
-	0x25, 0xf0, 0x29, 0x68, 0x48, 0x0f, 0xf5, 0xd9, 0xd5, 0x7e, 0x19, 0x4e, 0x47, 0x5f, 0x63, 0x27,
-	0xe5, 0xd1, 0x6f, 0xa4, 0x45, 0x7a, 0x9f, 0xe2, 0x94, 0xc0, 0x13, 0x50, 0x99, 0xb8, 0xca, 0x23,
-	0x29, 0x3f, 0xbc, 0x3f, 0xfa, 0xae, 0x67, 0xf3, 0x6b, 0x1e, 0x1d, 0x08, 0xc1, 0xe4, 0xec, 0xb6,
-	0x25, 0x0f, 0x25, 0x02, 0xfd, 0x11, 0x1c, 0x87, 0xa7, 0xa0, 0x9a, 0xc9, 0x77, 0x64, 0x68, 0xc0,
-	0x2c, 0x05, 0xf6, 0xe3, 0xef, 0x02, 0x3b, 0x55, 0xbe, 0xaf, 0xf5, 0xd2, 0x0d, 0x5c, 0x45, 0x03,
-	0x28, 0x3c, 0xef, 0x1d, 0x68, 0xdc, 0xe2, 0x29, 0xbf, 0xdf, 0x2a, 0x24, 0xd3, 0x38, 0xe4, 0x18,
-	0xfe, 0x02, 0x2c, 0x61, 0x21, 0xd8, 0xa8, 0x73, 0xdc, 0x21, 0x00, 0x3d, 0xcf, 0x7b, 0x01, 0xd6,
-	0x6e, 0x78, 0xdc, 0xca, 0x04, 0x81, 0x25, 0xa3, 0x33, 0x09, 0x55, 0xe3, 0xf6, 0x2f, 0xbf, 0xb9,
-	0xdc, 0x58, 0xf8, 0xf6, 0x72, 0x63, 0xe1, 0xdf, 0x97, 0x1b, 0x0b, 0x7f, 0xfd, 0xb0, 0xf1, 0xe0,
-	0xdb, 0x0f, 0x1b, 0x0f, 0xfe, 0xf9, 0x61, 0xe3, 0xc1, 0xef, 0xa6, 0x5b, 0x39, 0xe9, 0xcb, 0x4e,
-	0x3e, 0xf9, 0x2b, 0x69, 0xa0, 0xfe, 0x4c, 0x52, 0xa9, 0xea, 0x2c, 0xab, 0x3f, 0x89, 0x3e, 0xfd,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x37, 0x66, 0x64, 0x6a, 0x12, 0x00, 0x00,
+	// 1868 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x5b, 0x4f, 0xe4, 0xc8,
+	0x15, 0xe6, 0x62, 0xc0, 0x5d, 0xdd, 0x34, 0xa6, 0xba, 0x87, 0xed, 0x99, 0x51, 0x30, 0x71, 0x94,
+	0x88, 0x28, 0x3b, 0xb0, 0xb0, 0x42, 0x33, 0xda, 0x51, 0x2e, 0x34, 0xb0, 0xbb, 0x90, 0x19, 0x82,
+	0x6a, 0x98, 0x44, 0x8a, 0x14, 0x59, 0xd5, 0x76, 0xad, 0xf1, 0x60, 0xbb, 0x5a, 0x55, 0xe5, 0x9e,
+	0xee, 0x24, 0x6f, 0x79, 0x89, 0x94, 0x97, 0xfc, 0x82, 0x68, 0x7f, 0xce, 0x2a, 0x4f, 0xfb, 0x18,
+	0xe5, 0xc1, 0x8a, 0x98, 0x37, 0x1e, 0xf9, 0x03, 0x89, 0xea, 0xe2, 0xbe, 0xc1, 0x46, 0x0b, 0x4f,
+	0x5d, 0xe7, 0x52, 0xdf, 0x57, 0xe7, 0xd4, 0x29, 0x9f, 0xaa, 0x06, 0x4f, 0x88, 0xb8, 0x20, 0x2c,
+	0x8d, 0x33, 0xb1, 0x4d, 0x7a, 0xe9, 0x76, 0x6f, 0x47, 0xfe, 0x6c, 0x75, 0x19, 0x15, 0x14, 0x3a,
+	0x43, 0xdb, 0x96, 0x54, 0xf6, 0x76, 0x9e, 0x34, 0x23, 0x1a, 0x51, 0x65, 0xdc, 0x96, 0x23, 0xed,
+	0xe7, 0xfd, 0x65, 0x01, 0x2c, 0x9e, 0x61, 0x86, 0x53, 0x0e, 0x77, 0x40, 0x85, 0xf4, 0x52, 0x3f,
+	0x24, 0x19, 0x4d, 0x5b, 0xb3, 0x1b, 0xb3, 0x9b, 0x95, 0x76, 0xf3, 0xa6, 0x70, 0x9d, 0x01, 0x4e,
+	0x93, 0xcf, 0xbc, 0xa1, 0xc9, 0x43, 0x36, 0xe9, 0xa5, 0x87, 0x72, 0x08, 0x7f, 0x0e, 0x96, 0x49,
+	0x86, 0x3b, 0x09, 0xf1, 0x03, 0x46, 0xb0, 0x20, 0xad, 0xb9, 0x8d, 0xd9, 0x4d, 0xbb, 0xdd, 0xba,
+	0x29, 0xdc, 0xa6, 0x99, 0x36, 0x6e, 0xf6, 0x50, 0x4d, 0xcb, 0x07, 0x4a, 0x84, 0xcf, 0x41, 0xb5,
+	0xb4, 0xe3, 0x24, 0x69, 0xcd, 0xab, 0xc9, 0x6b, 0x37, 0x85, 0x0b, 0x27, 0x27, 0xe3, 0x24, 0xf1,
+	0x10, 0x30, 0x53, 0x71, 0x92, 0xc0, 0x7d, 0x00, 0x48, 0x5f, 0x30, 0xec, 0x93, 0xb8, 0xcb, 0x5b,
+	0xd6, 0xc6, 0xfc, 0xe6, 0x7c, 0xdb, 0xbb, 0x2a, 0xdc, 0xca, 0x91, 0xd4, 0x1e, 0x1d, 0x9f, 0xf1,
+	0x9b, 0xc2, 0x5d, 0x35, 0x20, 0x43, 0x47, 0x0f, 0x55, 0x94, 0x70, 0x14, 0x77, 0x39, 0xfc, 0x03,
+	0xa8, 0x05, 0x17, 0x38, 0xce, 0xfc, 0x80, 0x66, 0x5f, 0xc5, 0x51, 0x6b, 0x61, 0x63, 0x76, 0xb3,
+	0xba, 0xfb, 0x83, 0xad, 0xe9, 0xbc, 0x6d, 0x1d, 0x48, 0xaf, 0x03, 0xe5, 0xd4, 0x7e, 0xfa, 0x4d,
+	0xe1, 0xce, 0xdc, 0x14, 0x6e, 0x43, 0x43, 0x8f, 0x03, 0x78, 0xa8, 0x1a, 0x8c, 0x3c, 0x61, 0x0a,
+	0x1a, 0x24, 0xee, 0x3e, 0xdf, 0xd9, 0xf5, 0x71, 0x92, 0xd0, 0xf7, 0x24, 0xf4, 0x53, 0x1e, 0xf1,
+	0xd6, 0xe2, 0xc6, 0xfc, 0x66, 0x75, 0xd7, 0xbb, 0xcd, 0x72, 0x74, 0x7c, 0xf6, 0x7c, 0x67, 0x77,
+	0x5f, 0xfb, 0xbe, 0xe6, 0x51, 0xfb, 0xb1, 0xa4, 0xba, 0x2a, 0xdc, 0xd5, 0x69, 0x0b, 0x47, 0xab,
+	0x1a, 0x79, 0x4c, 0x05, 0x77, 0xc1, 0x23, 0xc5, 0xe3, 0xe7, 0x99, 0xdc, 0x57, 0x12, 0x08, 0x12,
+	0xfa, 0xa2, 0xcf, 0x5b, 0x4b, 0x32, 0xa7, 0xa8, 0xa1, 0x8c, 0x6f, 0x47, 0xb6, 0xf3, 0x3e, 0x87,
+	0xdb, 0xa0, 0xa1, 0x53, 0x1a, 0xfa, 0x5d, 0x46, 0x02, 0x9a, 0x76, 0xe3, 0x84, 0xf0, 0x96, 0xbd,
+	0x31, 0xbf, 0x59, 0x41, 0xd0, 0x98, 0xce, 0x46, 0x16, 0xf8, 0x33, 0xb0, 0x5a, 0x06, 0x13, 0x92,
+	0x6e, 0x42, 0x07, 0x84, 0xf1, 0x56, 0x45, 0xb9, 0x3b, 0xc6, 0x70, 0x58, 0xea, 0xa5, 0x73, 0x27,
+	0xa1, 0xc1, 0x25, 0x09, 0x65, 0x82, 0x04, 0xc3, 0x81, 0xe0, 0x2d, 0xa0, 0x9d, 0x8d, 0xe1, 0xa0,
+	0xd4, 0x7b, 0xff, 0x58, 0x05, 0xd5, 0x83, 0x89, 0xec, 0xad, 0x5c, 0xd0, 0x94, 0x70, 0x41, 0x70,
+	0xe8, 0x2b, 0x6f, 0x53, 0x90, 0x87, 0xff, 0x2e, 0xdc, 0x9f, 0x44, 0xb1, 0xb8, 0xc8, 0x3b, 0x5b,
+	0x01, 0x4d, 0xb7, 0x03, 0xca, 0x53, 0xca, 0xcd, 0xcf, 0x33, 0x1e, 0x5e, 0x6e, 0x8b, 0x41, 0x97,
+	0xf0, 0xad, 0xe3, 0x4c, 0xdc, 0x14, 0xee, 0x9a, 0xde, 0xa6, 0x29, 0x28, 0x0f, 0xd5, 0x87, 0x9a,
+	0xb6, 0x54, 0xc0, 0x01, 0xa8, 0x87, 0x98, 0xfa, 0x5f, 0x51, 0x76, 0x69, 0xd8, 0xe6, 0x14, 0xdb,
+	0x9b, 0xef, 0xcf, 0x76, 0x55, 0xb8, 0xb5, 0xc3, 0xfd, 0xdf, 0x7c, 0x4e, 0xd9, 0xa5, 0xc2, 0xbc,
+	0x29, 0xdc, 0x47, 0x9a, 0x7d, 0x12, 0xd9, 0x43, 0xb5, 0x10, 0xd3, 0xa1, 0x1b, 0xfc, 0x1d, 0x70,
+	0x86, 0x0e, 0x3c, 0xef, 0x76, 0x29, 0x13, 0xe6, 0x1c, 0x3c, 0xbb, 0x2a, 0xdc, 0xba, 0x81, 0x7c,
+	0xa3, 0x2d, 0x37, 0x85, 0xfb, 0xd1, 0x14, 0xa8, 0x99, 0xe3, 0xa1, 0xba, 0x81, 0x35, 0xae, 0x90,
+	0x83, 0x1a, 0x89, 0xbb, 0x3b, 0x7b, 0x9f, 0x98, 0x88, 0x2c, 0x15, 0xd1, 0xd9, 0xbd, 0x22, 0xaa,
+	0x1e, 0x1d, 0x9f, 0xed, 0xec, 0x7d, 0x52, 0x06, 0x64, 0xaa, 0x7e, 0x1c, 0xd6, 0x43, 0x55, 0x2d,
+	0xea, 0x68, 0x8e, 0x81, 0x11, 0xfd, 0x0b, 0xcc, 0x2f, 0xd4, 0x99, 0xaa, 0xb4, 0x37, 0xaf, 0x0a,
+	0x17, 0x68, 0xa4, 0x2f, 0x31, 0xbf, 0x18, 0xed, 0x4b, 0x67, 0xf0, 0x47, 0x9c, 0x89, 0x38, 0x4f,
+	0x4b, 0x2c, 0xa0, 0x27, 0x4b, 0xaf, 0xe1, 0xfa, 0xf7, 0xcc, 0xfa, 0x17, 0x1f, 0xbc, 0xfe, 0xbd,
+	0xbb, 0xd6, 0xbf, 0x37, 0xb9, 0x7e, 0xed, 0x33, 0x24, 0x7d, 0x61, 0x48, 0x97, 0x1e, 0x4c, 0xfa,
+	0xe2, 0x2e, 0xd2, 0x17, 0x93, 0xa4, 0xda, 0x47, 0x16, 0xfb, 0x54, 0x26, 0x5a, 0xf6, 0xc3, 0x8b,
+	0xfd, 0x56, 0x52, 0xeb, 0x43, 0x8d, 0xa6, 0xfb, 0x33, 0x68, 0x06, 0x34, 0xe3, 0x42, 0xea, 0x32,
+	0xda, 0x4d, 0x88, 0xe1, 0xac, 0x28, 0xce, 0xe3, 0x7b, 0x71, 0x3e, 0x35, 0xdf, 0xc1, 0x3b, 0xf0,
+	0x3c, 0xd4, 0x98, 0x54, 0x6b, 0xf6, 0x2e, 0x70, 0xba, 0x44, 0x10, 0xc6, 0x3b, 0x39, 0x8b, 0x0c,
+	0x33, 0x50, 0xcc, 0x47, 0xf7, 0x62, 0x36, 0xe7, 0x60, 0x1a, 0xcb, 0x43, 0x2b, 0x23, 0x95, 0x66,
+	0x7c, 0x07, 0xea, 0xb1, 0x5c, 0x46, 0x27, 0x4f, 0x0c, 0x5f, 0x55, 0xf1, 0x1d, 0xdc, 0x8b, 0xcf,
+	0x1c, 0xe6, 0x49, 0x24, 0x0f, 0x2d, 0x97, 0x0a, 0xcd, 0x95, 0x03, 0x98, 0xe6, 0x31, 0xf3, 0xa3,
+	0x04, 0x07, 0x31, 0x61, 0x86, 0xaf, 0xa6, 0xf8, 0xbe, 0xb8, 0x17, 0xdf, 0x63, 0xcd, 0x77, 0x1b,
+	0xcd, 0x43, 0x8e, 0x54, 0x7e, 0xa1, 0x75, 0x9a, 0x36, 0x04, 0xb5, 0x0e, 0x61, 0x49, 0x9c, 0x19,
+	0xc2, 0x65, 0x45, 0xb8, 0x7f, 0x2f, 0x42, 0x53, 0xa7, 0xe3, 0x38, 0x1e, 0xaa, 0x6a, 0x71, 0xc8,
+	0x92, 0xd0, 0x2c, 0xa4, 0x25, 0xcb, 0xea, 0xc3, 0x59, 0xc6, 0x71, 0x3c, 0x54, 0xd5, 0xa2, 0x66,
+	0xe9, 0x83, 0x06, 0x66, 0x8c, 0xbe, 0x9f, 0xca, 0x21, 0x54, 0x64, 0x5f, 0xde, 0x8b, 0xec, 0x89,
+	0x26, 0xbb, 0x03, 0xce, 0x43, 0xab, 0x4a, 0x3b, 0x91, 0xc5, 0x1c, 0xc0, 0x88, 0xe1, 0xc1, 0x14,
+	0x71, 0xf3, 0xe1, 0x9b, 0x77, 0x1b, 0xcd, 0x43, 0x8e, 0x54, 0x4e, 0xd0, 0xfe, 0x09, 0x34, 0x53,
+	0xc2, 0x22, 0xe2, 0x67, 0x44, 0xf0, 0x6e, 0x12, 0x0b, 0x43, 0xfc, 0xe8, 0xe1, 0xe7, 0xf1, 0x2e,
+	0x3c, 0x0f, 0x41, 0xa5, 0x3e, 0x35, 0xda, 0xe1, 0xe1, 0xe0, 0x17, 0x38, 0x8b, 0x2e, 0x70, 0x6c,
+	0x68, 0xd7, 0x1e, 0x7e, 0x38, 0x26, 0x91, 0x3c, 0xb4, 0x5c, 0x2a, 0x86, 0xf5, 0x13, 0xe0, 0x2c,
+	0xc8, 0xcb, 0xfa, 0xf9, 0xe8, 0xe1, 0xf5, 0x33, 0x8e, 0x23, 0x2f, 0x5e, 0x4a, 0x54, 0x2c, 0x27,
+	0x96, 0x5d, 0x77, 0x56, 0x4e, 0x2c, 0x7b, 0xc5, 0x71, 0x4e, 0x2c, 0xdb, 0x71, 0x56, 0x4f, 0x2c,
+	0xbb, 0xe1, 0x34, 0xd1, 0xf2, 0x80, 0x26, 0xd4, 0xef, 0x7d, 0xaa, 0x27, 0xa1, 0x2a, 0x79, 0x8f,
+	0xb9, 0xf9, 0x46, 0xa2, 0x7a, 0x80, 0x05, 0x4e, 0x06, 0xdc, 0xa4, 0x0a, 0x39, 0x3a, 0x81, 0x63,
+	0x5d, 0x7b, 0x1b, 0x2c, 0xbc, 0x11, 0xf2, 0xca, 0xea, 0x80, 0xf9, 0x4b, 0x32, 0xd0, 0xb7, 0x11,
+	0x24, 0x87, 0xb0, 0x09, 0x16, 0x7a, 0x38, 0xc9, 0xf5, 0xdd, 0xb7, 0x82, 0xb4, 0xe0, 0x9d, 0x81,
+	0x95, 0x73, 0x86, 0x33, 0x8e, 0x03, 0x11, 0xd3, 0xec, 0x15, 0x8d, 0x38, 0x84, 0xc0, 0x52, 0x5d,
+	0x51, 0xcf, 0x55, 0x63, 0xf8, 0x53, 0x60, 0x25, 0x34, 0xe2, 0xad, 0x39, 0x75, 0x2f, 0x7c, 0x74,
+	0xfb, 0x5e, 0xf8, 0x8a, 0x46, 0x48, 0xb9, 0x78, 0xff, 0x9c, 0x03, 0xf3, 0xaf, 0x68, 0x04, 0x5b,
+	0x60, 0x09, 0x87, 0x21, 0x23, 0x9c, 0x1b, 0xa4, 0x52, 0x84, 0x6b, 0x60, 0x51, 0xd0, 0x6e, 0x1c,
+	0x68, 0xb8, 0x0a, 0x32, 0x92, 0x24, 0x0e, 0xb1, 0xc0, 0xea, 0x5e, 0x51, 0x43, 0x6a, 0x0c, 0x77,
+	0x41, 0x4d, 0x45, 0xe6, 0x67, 0x79, 0xda, 0x21, 0x4c, 0x5d, 0x0f, 0xac, 0xf6, 0xca, 0x75, 0xe1,
+	0x56, 0x95, 0xfe, 0x54, 0xa9, 0xd1, 0xb8, 0x00, 0x3f, 0x06, 0x4b, 0xa2, 0x3f, 0xde, 0xd9, 0x1b,
+	0xd7, 0x85, 0xbb, 0x22, 0x46, 0x61, 0xca, 0xc6, 0x8d, 0x16, 0x45, 0x5f, 0xfe, 0xc2, 0x6d, 0x60,
+	0x8b, 0xbe, 0x1f, 0x67, 0x21, 0xe9, 0xab, 0xe6, 0x6d, 0xb5, 0x9b, 0xd7, 0x85, 0xeb, 0x8c, 0xb9,
+	0x1f, 0x4b, 0x1b, 0x5a, 0x12, 0x7d, 0x35, 0x80, 0x1f, 0x03, 0xa0, 0x97, 0xa4, 0x18, 0x74, 0xeb,
+	0x5d, 0xbe, 0x2e, 0xdc, 0x8a, 0xd2, 0x2a, 0xec, 0xd1, 0x10, 0x7a, 0x60, 0x41, 0x63, 0xdb, 0x0a,
+	0xbb, 0x76, 0x5d, 0xb8, 0x76, 0x42, 0x23, 0x8d, 0xa9, 0x4d, 0x32, 0x55, 0x8c, 0xa4, 0xb4, 0x47,
+	0x42, 0xd5, 0xdd, 0x6c, 0x54, 0x8a, 0xde, 0xdf, 0xe6, 0x80, 0x7d, 0xde, 0x47, 0x84, 0xe7, 0x89,
+	0x80, 0x9f, 0x03, 0xa7, 0xbc, 0xa2, 0xfa, 0x13, 0xa9, 0x6d, 0x3f, 0x1d, 0x75, 0x9a, 0x69, 0x0f,
+	0x0f, 0xad, 0x94, 0xaa, 0x7d, 0x93, 0xff, 0x26, 0x58, 0xe8, 0x24, 0x94, 0xa6, 0xaa, 0x12, 0x6a,
+	0x48, 0x0b, 0x10, 0xa9, 0xac, 0xa9, 0x5d, 0x9e, 0x57, 0x6f, 0x8c, 0x1f, 0xde, 0xde, 0xe5, 0xa9,
+	0x52, 0x69, 0xaf, 0x99, 0x77, 0x46, 0x5d, 0x73, 0x9b, 0xf9, 0x9e, 0xcc, 0xad, 0x2a, 0x25, 0x07,
+	0xcc, 0x33, 0x22, 0xd4, 0xa6, 0xd5, 0x90, 0x1c, 0xc2, 0x27, 0xc0, 0x66, 0xa4, 0x47, 0x98, 0x20,
+	0xa1, 0xda, 0x1c, 0x1b, 0x0d, 0x65, 0xf8, 0x18, 0xd8, 0x11, 0xe6, 0x7e, 0xce, 0x49, 0xa8, 0x77,
+	0x02, 0x2d, 0x45, 0x98, 0xbf, 0xe5, 0x24, 0xfc, 0xcc, 0xfa, 0xeb, 0xd7, 0xee, 0x8c, 0x87, 0x41,
+	0x75, 0x3f, 0x08, 0x08, 0xe7, 0xe7, 0x79, 0x37, 0x21, 0xff, 0xa7, 0xc2, 0x76, 0x41, 0x8d, 0x0b,
+	0xca, 0x70, 0x44, 0xfc, 0x4b, 0x32, 0x30, 0x75, 0xa6, 0xab, 0xc6, 0xe8, 0x7f, 0x4d, 0x06, 0x1c,
+	0x8d, 0x0b, 0x86, 0xe2, 0x6b, 0x0b, 0x54, 0xcf, 0x19, 0x0e, 0x88, 0xb9, 0xe1, 0xcb, 0x5a, 0x95,
+	0x22, 0x33, 0x14, 0x46, 0x92, 0xdc, 0x22, 0x4e, 0x09, 0xcd, 0x85, 0x39, 0x4f, 0xa5, 0x28, 0x67,
+	0x30, 0x42, 0xfa, 0x24, 0x50, 0x69, 0xb4, 0x90, 0x91, 0xe0, 0x1e, 0x58, 0x0e, 0x63, 0xae, 0x1e,
+	0x8a, 0x5c, 0xe0, 0xe0, 0x52, 0x87, 0xdf, 0x76, 0xae, 0x0b, 0xb7, 0x66, 0x0c, 0x6f, 0xa4, 0x1e,
+	0x4d, 0x48, 0xf0, 0x25, 0x58, 0x19, 0x4d, 0x53, 0xab, 0x55, 0xb9, 0xb1, 0xdb, 0xf0, 0xba, 0x70,
+	0xeb, 0x43, 0x57, 0x65, 0x41, 0x53, 0xb2, 0xdc, 0xe9, 0x90, 0x74, 0xf2, 0x48, 0x15, 0x9f, 0x8d,
+	0xb4, 0x20, 0xb5, 0x49, 0x9c, 0xc6, 0x42, 0x15, 0xdb, 0x02, 0xd2, 0x02, 0x7c, 0x09, 0x2a, 0xb4,
+	0x47, 0x18, 0x8b, 0x43, 0xc2, 0x5b, 0xe0, 0x7b, 0xbc, 0x32, 0xd1, 0xc8, 0x5f, 0x06, 0x67, 0x1e,
+	0xc1, 0x29, 0x49, 0x29, 0x1b, 0xb4, 0xaa, 0xa3, 0xe0, 0xb4, 0xe1, 0xb5, 0xd2, 0xa3, 0x09, 0x09,
+	0xb6, 0x81, 0x79, 0xbf, 0xf9, 0x8c, 0x88, 0x9c, 0x65, 0xbe, 0x3a, 0xff, 0x35, 0x35, 0x57, 0x9d,
+	0x42, 0x6d, 0x45, 0xca, 0x78, 0x88, 0x05, 0x46, 0xb7, 0x34, 0xf0, 0x17, 0x00, 0xea, 0x3d, 0xf1,
+	0xdf, 0x71, 0x3a, 0x7c, 0x26, 0xeb, 0xab, 0x85, 0xe2, 0xd7, 0x56, 0xb3, 0x66, 0x47, 0x4b, 0x27,
+	0x9c, 0x9a, 0x28, 0x4e, 0x2c, 0xdb, 0x72, 0x16, 0x4e, 0x2c, 0x7b, 0xc9, 0xb1, 0x87, 0xf9, 0x33,
+	0x51, 0xa0, 0x46, 0x29, 0x8f, 0x2d, 0xcf, 0xfb, 0xef, 0x2c, 0x70, 0xa6, 0x1f, 0xbb, 0x70, 0x03,
+	0xd4, 0x52, 0x1e, 0xf9, 0xb2, 0x07, 0xf8, 0x39, 0x4b, 0x4c, 0xb5, 0x80, 0x94, 0x47, 0xe7, 0x83,
+	0x2e, 0x79, 0xcb, 0x12, 0xf8, 0x0c, 0x34, 0xa4, 0x87, 0xfa, 0xec, 0x6a, 0xbf, 0x0c, 0xa7, 0xe5,
+	0xd7, 0xd8, 0x49, 0x79, 0xf4, 0x5b, 0x69, 0x91, 0xde, 0xa7, 0x38, 0x25, 0xf0, 0x04, 0x54, 0x47,
+	0xae, 0xf2, 0x48, 0xca, 0x0f, 0xef, 0x8f, 0xbe, 0xeb, 0x41, 0xfe, 0x9a, 0x47, 0xfb, 0x42, 0x30,
+	0x39, 0xbb, 0x6d, 0xc9, 0x43, 0x89, 0x40, 0xaf, 0x84, 0xe3, 0xf0, 0x14, 0xd4, 0x32, 0xc2, 0xd5,
+	0x53, 0x5b, 0x81, 0x59, 0x0a, 0xec, 0xc7, 0xdf, 0x05, 0x76, 0xaa, 0x7c, 0x5f, 0xf3, 0x68, 0x0c,
+	0xae, 0xaa, 0x01, 0x14, 0x9e, 0xf7, 0x0e, 0x34, 0xee, 0xf0, 0x94, 0xdf, 0x6f, 0x15, 0x92, 0x69,
+	0x1c, 0x72, 0x0c, 0x7f, 0x09, 0x16, 0xb0, 0x10, 0xac, 0xec, 0x1c, 0xf7, 0x08, 0x40, 0xcf, 0xf3,
+	0x5e, 0x82, 0xd5, 0x5b, 0x1e, 0x77, 0x32, 0x41, 0x60, 0xc9, 0xe8, 0x4c, 0x42, 0xd5, 0xb8, 0xfd,
+	0xab, 0x6f, 0xae, 0xd6, 0x67, 0xbf, 0xbd, 0x5a, 0x9f, 0xfd, 0xcf, 0xd5, 0xfa, 0xec, 0xdf, 0x3f,
+	0xac, 0xcf, 0x7c, 0xfb, 0x61, 0x7d, 0xe6, 0x5f, 0x1f, 0xd6, 0x67, 0x7e, 0x3f, 0xde, 0xca, 0x49,
+	0x4f, 0x76, 0xf2, 0xd1, 0x9f, 0x54, 0x7d, 0xa9, 0xd1, 0xed, 0xbc, 0xb3, 0xa8, 0xfe, 0x7e, 0xfa,
+	0xf4, 0x7f, 0x03, 0x00, 0x09, 0x3b, 0xc3, 0x87, 0xc4, 0x12, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1034,6 +1058,24 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockedContracts) > 0 {
+		for iNdEx := len(m.BlockedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedContracts[iNdEx])
+			copy(dAtA[i:], m.BlockedContracts[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.BlockedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AllowedDeployers) > 0 {
+		for iNdEx := len(m.AllowedDeployers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDeployers[iNdEx])
+			copy(dAtA[i:], m.AllowedDeployers[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AllowedDeployers[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.EnabledPrecompiles) > 0 {
 		for iNdEx := len(m.EnabledPrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EnabledPrecompiles[iNdEx])
@@ -1969,6 +2011,18 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	if len(m.AllowedDeployers) > 0 {
+		for _, s := range m.AllowedDeployers {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.BlockedContracts) > 0 {
+		for _, s := range m.BlockedContracts {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2596,6 +2650,70 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.EnabledPrecompiles = append(m.EnabledPrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDeployers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDeployers = append(m.AllowedDeployers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedContracts = append(m.BlockedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -0,0 +1,59 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+var (
+	_ EvmHooks     = NoOpEvmHooks{}
+	_ EvmCallHooks = NoOpEvmCallHooks{}
+)
+
+// NoOpEvmHooks implements EvmHooks with hooks doing nothing, it's embedded by
+// the hooks which only implement some of them.
+type NoOpEvmHooks struct{}
+
+// PreTxProcessing implements EvmHooks
+func (NoOpEvmHooks) PreTxProcessing(sdk.Context, core.Message) error {
+	return nil
+}
+
+// PostTxProcessing implements EvmHooks
+func (NoOpEvmHooks) PostTxProcessing(sdk.Context, core.Message, *ethtypes.Receipt) error {
+	return nil
+}
+
+// NoOpEvmCallHooks implements EvmCallHooks with hooks doing nothing, it's
+// embedded by the call hooks which only implement some of them.
+type NoOpEvmCallHooks struct{}
+
+// CallEnter implements EvmCallHooks
+func (NoOpEvmCallHooks) CallEnter(sdk.Context, vm.OpCode, common.Address, common.Address, []byte, uint64, *big.Int) error {
+	return nil
+}
+
+// CallExit implements EvmCallHooks
+func (NoOpEvmCallHooks) CallExit(sdk.Context, []byte, uint64, error) error {
+	return nil
+}
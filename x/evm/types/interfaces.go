@@ -22,6 +22,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -68,12 +69,27 @@ type FeeMarketKeeper interface {
 // Event Hooks
 // These can be utilized to customize evm transaction processing.
 
-// EvmHooks event hooks for evm tx processing
+// EvmHooks event hooks for evm tx processing. Embed NoOpEvmHooks to only implement some of them.
 type EvmHooks interface {
+	// Must be called before tx is processed, if return an error, the whole transaction is reverted without being executed.
+	PreTxProcessing(ctx sdk.Context, msg core.Message) error
 	// Must be called after tx is processed successfully, if return an error, the whole transaction is reverted.
 	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
 }
 
+// EvmCallHooks call hooks for the internal calls and contract creations of the evm txs,
+// called by the tracer of the EVM. The state of the EVM isn't committed to the context yet.
+// The hooks are run on a branch of the context per call, the writes made during a call are
+// dropped if it reverts. Embed NoOpEvmCallHooks to only implement some of them.
+type EvmCallHooks interface {
+	// Called when the EVM enters an internal call, on the context of the call.
+	// If return an error, the whole transaction is reverted once executed.
+	CallEnter(ctx sdk.Context, typ vm.OpCode, from, to common.Address, input []byte, gas uint64, value *big.Int) error
+	// Called when the EVM exits an internal call, on the context of the caller.
+	// If return an error, the whole transaction is reverted once executed.
+	CallExit(ctx sdk.Context, output []byte, gasUsed uint64, err error) error
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
@@ -116,9 +116,38 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateAddressList("allowed deployers", p.AllowedDeployers); err != nil {
+		return err
+	}
+
+	if err := validateAddressList("blocked contracts", p.BlockedContracts); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// IsDeployerAllowed returns true if the address can create contracts, any
+// address can if the allowed deployers list is empty.
+func (p Params) IsDeployerAllowed(addr common.Address) bool {
+	return len(p.AllowedDeployers) == 0 || containsAddress(p.AllowedDeployers, addr)
+}
+
+// IsContractBlocked returns true if the contract at the address can't be called.
+func (p Params) IsContractBlocked(addr common.Address) bool {
+	return containsAddress(p.BlockedContracts, addr)
+}
+
+// containsAddress returns true if the hex-encoded addresses contain the address.
+func containsAddress(addrs []string, addr common.Address) bool {
+	for _, hexAddr := range addrs {
+		if common.HexToAddress(hexAddr) == addr {
+			return true
+		}
+	}
+	return false
+}
+
 // EIP712AllowedMsgFromMsgType returns the EIP712AllowedMsg for a given message type url.
 func (p Params) EIP712AllowedMsgFromMsgType(msgTypeURL string) *EIP712AllowedMsg {
 	for _, allowedMsg := range p.EIP712AllowedMsgs {
@@ -198,23 +227,29 @@ func validateEIP712AllowedMsgs(i interface{}) error {
 }
 
 func validateEnabledPrecompiles(enabledPrecompiles []string) error {
-	for _, addr := range enabledPrecompiles {
+	return validateAddressList("enabled precompiles", enabledPrecompiles)
+}
+
+// validateAddressList checks that the named list contains hex addresses sorted
+// in ascending order and unique.
+func validateAddressList(name string, hexAddrs []string) error {
+	for _, addr := range hexAddrs {
 		if !common.IsHexAddress(addr) {
-			return fmt.Errorf("invalid hex address: %v in enabled precompiles list", addr)
+			return fmt.Errorf("invalid hex address: %v in %s list", addr, name)
 		}
 	}
 
-	addrs := make([]common.Address, len(enabledPrecompiles))
-	for i, precompile := range enabledPrecompiles {
-		addrs[i] = common.HexToAddress(precompile)
+	addrs := make([]common.Address, len(hexAddrs))
+	for i, addr := range hexAddrs {
+		addrs[i] = common.HexToAddress(addr)
 	}
 
 	if err := validateSortingInBytesRepr(addrs); err != nil {
-		return fmt.Errorf("enabled precompiles are not sorted: %v", err)
+		return fmt.Errorf("%s are not sorted: %v", name, err)
 	}
 
 	if err := validateUniquenessInBytesRepr(addrs); err != nil {
-		return fmt.Errorf("enabled precompiles are not unique: %v", err)
+		return fmt.Errorf("%s are not unique: %v", name, err)
 	}
 
 	return nil
@@ -55,6 +55,34 @@ func TestParamsValidate(t *testing.T) {
 			},
 			expError: true,
 		},
+		{
+			name: "valid access control",
+			getParams: func() Params {
+				params := DefaultParams()
+				params.AllowedDeployers = []string{"0x0000000000000000000000000000000000000001", "0x0000000000000000000000000000000000000002"}
+				params.BlockedContracts = []string{"0x0000000000000000000000000000000000000003"}
+				return params
+			},
+			expError: false,
+		},
+		{
+			name: "unsorted allowed deployers",
+			getParams: func() Params {
+				params := DefaultParams()
+				params.AllowedDeployers = []string{"0x0000000000000000000000000000000000000002", "0x0000000000000000000000000000000000000001"}
+				return params
+			},
+			expError: true,
+		},
+		{
+			name: "invalid blocked contract",
+			getParams: func() Params {
+				params := DefaultParams()
+				params.BlockedContracts = []string{"0x01"}
+				return params
+			},
+			expError: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -264,6 +292,22 @@ func TestEnabledPrecompilesUniquenessInBytesRepr(t *testing.T) {
 	}
 }
 
+func TestParamsAccessControl(t *testing.T) {
+	deployer := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000002")
+
+	params := DefaultParams()
+	require.True(t, params.IsDeployerAllowed(deployer))
+	require.False(t, params.IsContractBlocked(contract))
+
+	params.AllowedDeployers = []string{deployer.Hex()}
+	params.BlockedContracts = []string{contract.Hex()}
+	require.True(t, params.IsDeployerAllowed(deployer))
+	require.False(t, params.IsDeployerAllowed(contract))
+	require.True(t, params.IsContractBlocked(contract))
+	require.False(t, params.IsContractBlocked(deployer))
+}
+
 func TestParamsEIPs(t *testing.T) {
 	extraEips := []int64{2929, 1884, 1344}
 	params := NewParams("ara", false, true, true, DefaultChainConfig(), extraEips, []EIP712AllowedMsg{})
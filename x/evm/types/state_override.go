@@ -0,0 +1,93 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Validate checks that none of the overridden accounts sets both the full
+// state and the state diff.
+func (diff StateOverride) Validate() error {
+	for addr, account := range diff {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+	}
+	return nil
+}
+
+// BlockOverrides is a set of header fields to override during the execution
+// of a message call.
+type BlockOverrides struct {
+	Number   *hexutil.Big    `json:"number"`
+	Time     *hexutil.Uint64 `json:"time"`
+	Coinbase *common.Address `json:"coinbase"`
+	BaseFee  *hexutil.Big    `json:"baseFee"`
+}
+
+// UnmarshalStateOverride decodes the json-encoded state overrides of an
+// EthCallRequest. It returns nil if no overrides are provided.
+func UnmarshalStateOverride(bz []byte) (StateOverride, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var overrides StateOverride
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return nil, err
+	}
+
+	if err := overrides.Validate(); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// UnmarshalBlockOverrides decodes the json-encoded block overrides of an
+// EthCallRequest. It returns nil if no overrides are provided.
+func UnmarshalBlockOverrides(bz []byte) (*BlockOverrides, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var overrides BlockOverrides
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return nil, err
+	}
+	return &overrides, nil
+}
@@ -16,6 +16,7 @@
 package types
 
 import (
+	"encoding/json"
 	"math/big"
 	"os"
 	"time"
@@ -64,6 +65,32 @@ type TxTraceResult struct {
 	Error  string      `json:"error,omitempty"`  // Trace failure produced by the tracer
 }
 
+// UnmarshalJSON decodes the trace config, the tracer config is accepted both as
+// a json object, as go-ethereum clients send it, and as a json-encoded string.
+func (tc *TraceConfig) UnmarshalJSON(bz []byte) error {
+	// traceConfig drops the methods of TraceConfig to avoid a recursive call
+	type traceConfig TraceConfig
+	aux := struct {
+		*traceConfig
+		TracerJSONConfig json.RawMessage `json:"tracerConfig"`
+	}{
+		traceConfig: (*traceConfig)(tc),
+	}
+	if err := json.Unmarshal(bz, &aux); err != nil {
+		return err
+	}
+
+	tc.TracerJsonConfig = ""
+	if len(aux.TracerJSONConfig) == 0 || string(aux.TracerJSONConfig) == "null" {
+		return nil
+	}
+	if aux.TracerJSONConfig[0] == '"' {
+		return json.Unmarshal(aux.TracerJSONConfig, &tc.TracerJsonConfig)
+	}
+	tc.TracerJsonConfig = string(aux.TracerJSONConfig)
+	return nil
+}
+
 var _ vm.EVMLogger = &NoOpTracer{}
 
 // NoOpTracer is an empty implementation of vm.Tracer interface
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,3 +10,56 @@ import (
 func TestNewNoOpTracer(t *testing.T) {
 	require.Equal(t, &NoOpTracer{}, NewNoOpTracer())
 }
+
+func TestTraceConfigUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected TraceConfig
+		expPass  bool
+	}{
+		{
+			"tracer config object",
+			`{"tracer":"callTracer","tracerConfig":{"onlyTopCall":true}}`,
+			TraceConfig{Tracer: "callTracer", TracerJsonConfig: `{"onlyTopCall":true}`},
+			true,
+		},
+		{
+			"tracer config string",
+			`{"tracer":"callTracer","tracerConfig":"{\"onlyTopCall\":true}"}`,
+			TraceConfig{Tracer: "callTracer", TracerJsonConfig: `{"onlyTopCall":true}`},
+			true,
+		},
+		{
+			"no tracer config",
+			`{"disableStack":true,"limit":10}`,
+			TraceConfig{DisableStack: true, Limit: 10},
+			true,
+		},
+		{
+			"null tracer config",
+			`{"tracer":"prestateTracer","tracerConfig":null}`,
+			TraceConfig{Tracer: "prestateTracer"},
+			true,
+		},
+		{
+			"invalid field type",
+			`{"tracer":1}`,
+			TraceConfig{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var config TraceConfig
+			err := json.Unmarshal([]byte(tc.input), &config)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, config)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}